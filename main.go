@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/docker/cli/cli-plugins/manager"
 	"github.com/docker/cli/cli-plugins/plugin"
@@ -16,15 +17,18 @@ import (
 
 func main() {
 	plugin.Run(func(dockerCli command.Cli) *cobra.Command {
+		var statsWindow time.Duration
+
 		cmd := &cobra.Command{
 			Use:   "status [OPTIONS]",
 			Short: "Docker container and image management TUI",
 			Long: `A Docker CLI plugin for managing Docker containers and images in a terminal user interface.
 Provides an interactive way to view and manage your Docker resources.`,
 			RunE: func(cmd *cobra.Command, args []string) error {
-				return runPlugin(dockerCli, args)
+				return runPlugin(dockerCli, statsWindow)
 			},
 		}
+		cmd.Flags().DurationVar(&statsWindow, "stats-history", tui.DefaultStatsWindow, "How much CPU, memory and network history to keep per container")
 		return cmd
 	},
 		manager.Metadata{
@@ -36,7 +40,7 @@ Provides an interactive way to view and manage your Docker resources.`,
 		})
 }
 
-func runPlugin(dockerCli command.Cli, args []string) error {
+func runPlugin(dockerCli command.Cli, statsWindow time.Duration) error {
 	ctx := context.Background()
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -49,6 +53,7 @@ func runPlugin(dockerCli command.Cli, args []string) error {
 	}
 
 	model := tui.NewModel(cli, dockerCli)
+	model.SetStatsWindow(statsWindow)
 	program := tea.NewProgram(model, tea.WithAltScreen())
 
 	_, err = program.Run()
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/container"
+)
+
+// tagPattern is the format Docker accepts for image tags
+var tagPattern = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
+// containerCommittedMsg reports the image created from a container
+type containerCommittedMsg struct {
+	imageID   string
+	reference string
+}
+
+// showCommitForm asks for the details of an image to create from the selected container
+func (m *Model) showCommitForm() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	form := NewFormDialog(fmt.Sprintf("Commit container '%s' to an image", name)).
+		AddInput("repository", "Repository", "myrepo/debug", name+"-snapshot").
+		AddInput("tag", "Tag", "latest", "latest").
+		AddInput("author", "Author", "Jane Doe <jane@example.com>", "").
+		AddInput("message", "Message", "commit message", "").
+		AddToggle("pause", "Pause container while committing", true).
+		AddTextArea("changes", "Dockerfile changes", `CMD ["sh"]`, "").
+		SetHint("repository", "Leave empty for an untagged image").
+		SetHint("changes", "One instruction per line, Alt+Enter for a new line (CMD, ENTRYPOINT, ENV, EXPOSE, LABEL, USER, VOLUME, WORKDIR)")
+
+	container := *cont
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		options, err := commitOptions(form)
+		if err != nil {
+			return nil, err
+		}
+		m.status = fmt.Sprintf("Committing container %s...", container.ID[:12])
+		return m.commitContainer(container, options), nil
+	})
+}
+
+// commitOptions validates the commit form
+func commitOptions(form *FormDialog) (container.CommitOptions, error) {
+	repository, tag := form.Value("repository"), form.Value("tag")
+
+	options := container.CommitOptions{
+		Author:  form.Value("author"),
+		Comment: form.Value("message"),
+		Pause:   form.Checked("pause"),
+	}
+
+	switch {
+	case repository == "" && tag != "":
+		return options, errors.New("a tag needs a repository")
+	case repository != "":
+		if tag == "" {
+			tag = "latest"
+		}
+		if !tagPattern.MatchString(tag) {
+			return options, fmt.Errorf("invalid tag %q", tag)
+		}
+		named, err := reference.ParseNormalizedNamed(repository)
+		if err != nil {
+			return options, fmt.Errorf("invalid repository: %w", err)
+		}
+		if !reference.IsNameOnly(named) {
+			return options, errors.New("repository must not contain a tag or digest")
+		}
+		options.Reference = reference.FamiliarString(named) + ":" + tag
+	}
+
+	options.Changes = dockerfileChanges(form)
+	return options, nil
+}
+
+// dockerfileChanges returns the instructions of the changes field, one per
+// line, so quoted or bracketed arguments are passed on untouched
+func dockerfileChanges(form *FormDialog) []string {
+	var changes []string
+	for _, line := range form.Lines("changes") {
+		changes = append(changes, strings.TrimSpace(line))
+	}
+	return changes
+}
+
+func (m *Model) commitContainer(cont container.Summary, options container.CommitOptions) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.dockerClient.ContainerCommit(m.ctx, cont.ID, options)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return containerCommittedMsg{imageID: resp.ID, reference: options.Reference}
+	}
+}
+
+// handleContainerCommitted switches to the images view and selects the new
+// image once the image list has been refreshed
+func (m *Model) handleContainerCommitted(msg containerCommittedMsg) tea.Cmd {
+	name := msg.reference
+	if name == "" {
+		name = strings.TrimPrefix(msg.imageID, "sha256:")[:12]
+	}
+
+	m.currentView = ImagesView
+	m.selectImageID = msg.imageID
+	m.selectImageStatus = fmt.Sprintf("Committed container as image %s", name)
+	return m.refreshData()
+}
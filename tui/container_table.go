@@ -86,6 +86,12 @@ func (ct *ContainerTable) SetColumns(columns []table.Column) {
 	ct.table.SetColumns(columns)
 }
 
+// ResetColumns drops the current rows before the column set changes, since
+// the table cannot render rows with more cells than it has columns
+func (ct *ContainerTable) ResetColumns() {
+	ct.table.SetRows(nil)
+}
+
 // Update updates the table data based on current containers
 func (ct *ContainerTable) Update() {
 	if ct.model.groupByCompose {
@@ -97,6 +103,16 @@ func (ct *ContainerTable) Update() {
 	}
 }
 
+// displayNames joins container names without the leading slash the API
+// reports them with
+func displayNames(names []string) string {
+	trimmed := make([]string, len(names))
+	for i, name := range names {
+		trimmed[i] = strings.TrimPrefix(name, "/")
+	}
+	return strings.Join(trimmed, ", ")
+}
+
 func (ct *ContainerTable) updateFlat() {
 	rows := make([]table.Row, len(ct.model.containers))
 	ct.containerStates = make([]string, len(ct.model.containers))
@@ -107,10 +123,7 @@ func (ct *ContainerTable) updateFlat() {
 		status := StyleContainerStatusText(container.Status, container.State)
 		ports := formatPorts(container.Ports)
 
-		names := strings.Join(container.Names, ", ")
-		if len(names) > 0 && names[0] == '/' {
-			names = names[1:] // Remove leading slash
-		}
+		names := displayNames(container.Names)
 
 		// Store plain text for proper layout
 		rows[i] = table.Row{
@@ -122,6 +135,9 @@ func (ct *ContainerTable) updateFlat() {
 			status,
 			ports,
 		}
+		if ct.model.showStats {
+			rows[i] = append(rows[i], ct.model.statsCells(container)...)
+		}
 
 		// Track container state for styling
 		ct.containerStates[i] = container.State
@@ -139,15 +155,24 @@ func (ct *ContainerTable) updateGrouped() {
 		groupStatus := ct.getGroupStatus(group)
 		groupPorts := ct.getGroupPorts(group)
 
+		// Summed usage goes in its columns when shown, otherwise in the spare image cell
+		groupUsage := ""
+		if !ct.model.showStats {
+			groupUsage = ct.model.groupUsageSummary(group)
+		}
+
 		groupRow := table.Row{
 			"", // No ID for group
 			fmt.Sprintf("📁 %s (%d containers)", group.Name, len(group.Containers)),
-			"",
+			groupUsage,
 			"",
 			groupStatus,
 			groupPorts,
 			"",
 		}
+		if ct.model.showStats {
+			groupRow = append(groupRow, ct.model.groupStatsCells(group)...)
+		}
 		rows = append(rows, groupRow)
 		states = append(states, "group") // Special state for group headers
 
@@ -157,10 +182,7 @@ func (ct *ContainerTable) updateGrouped() {
 			status := StyleContainerStatusText(container.Status, container.State)
 			ports := formatPorts(container.Ports)
 
-			names := strings.Join(container.Names, ", ")
-			if len(names) > 0 && names[0] == '/' {
-				names = names[1:] // Remove leading slash
-			}
+			names := displayNames(container.Names)
 
 			containerRow := table.Row{
 				"  " + container.ID[:12],
@@ -171,6 +193,11 @@ func (ct *ContainerTable) updateGrouped() {
 				"  " + status,
 				"  " + ports,
 			}
+			if ct.model.showStats {
+				for _, cell := range ct.model.statsCells(container) {
+					containerRow = append(containerRow, "  "+cell)
+				}
+			}
 			rows = append(rows, containerRow)
 			states = append(states, container.State)
 		}
@@ -336,6 +363,33 @@ func (ct *ContainerTable) Cursor() int {
 	return ct.table.Cursor()
 }
 
+// SelectContainer moves the cursor to the row of the container with the
+// given ID, reporting whether it is listed
+func (ct *ContainerTable) SelectContainer(id string) bool {
+	if !ct.model.groupByCompose {
+		for i, container := range ct.model.containers {
+			if container.ID == id {
+				ct.table.SetCursor(i)
+				return true
+			}
+		}
+		return false
+	}
+
+	currentRow := 0
+	for _, group := range ct.model.containerGroups {
+		currentRow++ // Group header row
+		for _, container := range group.Containers {
+			if container.ID == id {
+				ct.table.SetCursor(currentRow)
+				return true
+			}
+			currentRow++
+		}
+	}
+	return false
+}
+
 // UpdateContainerGroups updates the container groups for grouped display
 func (ct *ContainerTable) UpdateContainerGroups() {
 	ct.model.containerGroups = ct.model.groupContainersByCompose()
@@ -0,0 +1,397 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
+	"github.com/google/shlex"
+)
+
+// containerNamePattern is the format Docker accepts for container names
+var containerNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+// containerCreatedMsg reports a container created by the run wizard
+type containerCreatedMsg struct {
+	id     string
+	status string
+}
+
+// createRequest is everything ContainerCreate needs
+type createRequest struct {
+	name       string
+	config     *container.Config
+	hostConfig *container.HostConfig
+	network    *network.NetworkingConfig
+	start      bool
+	pull       bool // The image is not available locally
+}
+
+// showCreateWizard opens the run wizard, prefilled with the selected image
+// when started from the images view
+func (m *Model) showCreateWizard() {
+	imageRef := ""
+	if m.currentView == ImagesView {
+		if img := m.imageTable.GetSelectedImage(); img != nil {
+			imageRef = strings.TrimPrefix(img.ID, "sha256:")[:12]
+			if len(img.RepoTags) > 0 && img.RepoTags[0] != "<none>:<none>" {
+				imageRef = img.RepoTags[0]
+			}
+		}
+	}
+
+	form := m.newRunForm("Run a new container", "").
+		SetValue("image", imageRef)
+
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		req, err := m.createRequest(form, "")
+		if err != nil {
+			return nil, err
+		}
+		if req.pull {
+			m.status = fmt.Sprintf("Pulling %s...", req.config.Image)
+			return m.pullImage(req.config.Image, m.createContainer(req)), nil
+		}
+		m.status = fmt.Sprintf("Creating container from %s...", req.config.Image)
+		return m.createContainer(req), nil
+	})
+}
+
+// newRunForm builds the run wizard; exceptID is the container being
+// recreated, whose name may be reused
+func (m *Model) newRunForm(title, exceptID string) *FormDialog {
+	return NewFormDialog(title).
+		AddStep("Image and command", func(form *FormDialog) error {
+			return m.validateCreateImage(form, exceptID)
+		}).
+		AddInput("image", "Image", "nginx:latest", "").
+		AddInput("name", "Name", "web", "").
+		AddInput("command", "Command", `sh -c "echo hello"`, "").
+		SetHint("image", "Pulled first when it is not available locally").
+		SetHint("name", "Leave empty for a generated name").
+		SetHint("command", "Leave empty for the image's default command").
+		AddStep("Environment, ports and mounts", validateCreateMappings).
+		AddTextArea("env", "Environment", "KEY=value", "").
+		AddInput("ports", "Port mappings", "8080:80; 127.0.0.1:8443:443/tcp", "").
+		AddInput("mounts", "Volumes and bind mounts", "data:/var/lib/data; ~/site:/usr/share/nginx/html:ro", "").
+		SetHint("env", "One KEY=value per line, Alt+Enter for a new line").
+		SetHint("ports", "[ip:]host:container[/proto], separated by ';'").
+		SetHint("mounts", "volume:/path or /host/path:/path, optionally :ro, separated by ';'").
+		AddStep("Network, restart policy and limits", func(form *FormDialog) error {
+			_, err := m.createRequest(form, exceptID)
+			return err
+		}).
+		AddInput("network", "Network", "bridge", "").
+		AddInput("restart", "Restart policy", "no, always, unless-stopped, on-failure[:max-retries]", "no").
+		AddInput("memory", "Memory limit", "512m", "").
+		AddInput("cpus", "CPUs", "1.5", "").
+		AddToggle("autoremove", "Remove container when it exits", false).
+		AddToggle("start", "Start container after creating it", true).
+		SetHint("network", "Leave empty for the default bridge network")
+}
+
+// validateCreateImage checks the first step of the run wizard
+func (m *Model) validateCreateImage(form *FormDialog, exceptID string) error {
+	if _, _, err := m.resolveImage(form.Value("image")); err != nil {
+		return err
+	}
+	if name := form.Value("name"); name != "" {
+		if err := m.validateContainerName(name, exceptID); err != nil {
+			return err
+		}
+	}
+	_, err := shlex.Split(form.Value("command"))
+	return err
+}
+
+// validateCreateMappings checks the second step of the run wizard
+func validateCreateMappings(form *FormDialog) error {
+	if _, _, err := parsePortMappings(form.Value("ports")); err != nil {
+		return err
+	}
+	if _, err := parseMounts(form.Value("mounts")); err != nil {
+		return err
+	}
+	_, err := parseEnv(form.Lines("env"))
+	return err
+}
+
+// resolveImage returns the reference to create the container from and
+// whether the image is available locally; images that are not have to be
+// pulled first
+func (m *Model) resolveImage(ref string) (string, bool, error) {
+	if ref == "" {
+		return "", false, errors.New("an image is required")
+	}
+
+	id := strings.TrimPrefix(ref, "sha256:")
+	for _, img := range m.images {
+		if len(id) >= 12 && strings.HasPrefix(strings.TrimPrefix(img.ID, "sha256:"), id) {
+			return img.ID, true, nil
+		}
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid image: %w", err)
+	}
+	familiar := reference.FamiliarString(reference.TagNameOnly(named))
+	for _, img := range m.images {
+		if slices.Contains(img.RepoTags, familiar) || slices.Contains(img.RepoDigests, familiar) {
+			return familiar, true, nil
+		}
+	}
+	return familiar, false, nil
+}
+
+// validateContainerName checks the format of a container name and that no
+// other container than exceptID uses it
+func (m *Model) validateContainerName(name, exceptID string) error {
+	name = strings.TrimPrefix(name, "/")
+	if !containerNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid name %q: use letters, digits, '_', '.' and '-'", name)
+	}
+	for _, cont := range m.containers {
+		if cont.ID == exceptID {
+			continue
+		}
+		for _, existing := range cont.Names {
+			// Names are reported with a leading slash
+			if strings.TrimPrefix(existing, "/") == name {
+				return fmt.Errorf("name %q is already used by container %s", name, cont.ID[:12])
+			}
+		}
+	}
+	return nil
+}
+
+// splitList splits a ';' separated form value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseEnv parses one KEY=value pair per line. Values are kept verbatim,
+// including ';' and surrounding spaces.
+func parseEnv(lines []string) ([]string, error) {
+	env := make([]string, 0, len(lines))
+	for _, line := range lines {
+		kv := strings.TrimLeft(line, " \t")
+		if key, _, _ := strings.Cut(kv, "="); key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("invalid environment variable %q", kv)
+		}
+		env = append(env, kv)
+	}
+	return env, nil
+}
+
+// parsePortMappings parses port mappings in the format of `docker run -p`
+func parsePortMappings(value string) (nat.PortSet, nat.PortMap, error) {
+	exposed, bindings, err := nat.ParsePortSpecs(splitList(value))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid port mapping: %w", err)
+	}
+	return exposed, bindings, nil
+}
+
+// parseMounts parses source:target[:ro|rw] items; sources that are paths
+// become bind mounts, anything else a named volume
+func parseMounts(value string) ([]mount.Mount, error) {
+	var mounts []mount.Mount
+	for _, item := range splitList(value) {
+		parts := strings.Split(item, ":")
+		readOnly := false
+		if n := len(parts); n == 3 && (parts[2] == "ro" || parts[2] == "rw") {
+			readOnly = parts[2] == "ro"
+			parts = parts[:2]
+		}
+		if len(parts) != 2 || parts[0] == "" || !strings.HasPrefix(parts[1], "/") {
+			return nil, fmt.Errorf("invalid mount %q: expected source:/path[:ro]", item)
+		}
+
+		mnt := mount.Mount{Type: mount.TypeVolume, Source: parts[0], Target: parts[1], ReadOnly: readOnly}
+		if strings.HasPrefix(parts[0], "/") || strings.HasPrefix(parts[0], ".") || strings.HasPrefix(parts[0], "~") {
+			source, err := expandHome(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			if source, err = filepath.Abs(source); err != nil {
+				return nil, err
+			}
+			mnt.Type, mnt.Source = mount.TypeBind, source
+		}
+		mounts = append(mounts, mnt)
+	}
+	return mounts, nil
+}
+
+// parseRestartPolicy parses a policy in the format of `docker run --restart`
+func parseRestartPolicy(value string) (container.RestartPolicy, error) {
+	name, retries, hasRetries := strings.Cut(value, ":")
+	policy := container.RestartPolicy{Name: container.RestartPolicyMode(name)}
+	if name == "" {
+		policy.Name = container.RestartPolicyDisabled
+	}
+	if hasRetries {
+		count, err := strconv.Atoi(retries)
+		if err != nil {
+			return policy, fmt.Errorf("invalid restart policy %q", value)
+		}
+		policy.MaximumRetryCount = count
+	}
+	if err := container.ValidateRestartPolicy(policy); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// parseLimits parses a memory size like 512m and a CPU count like 1.5;
+// empty values mean no limit
+func parseLimits(memory, cpus string) (container.Resources, error) {
+	var resources container.Resources
+	if memory != "" {
+		bytes, err := units.RAMInBytes(memory)
+		if err != nil {
+			return resources, fmt.Errorf("invalid memory limit: %w", err)
+		}
+		resources.Memory = bytes
+	}
+	if cpus != "" {
+		count, err := strconv.ParseFloat(cpus, 64)
+		if err != nil || count <= 0 {
+			return resources, fmt.Errorf("invalid CPU count %q", cpus)
+		}
+		resources.NanoCPUs = int64(count * 1e9)
+	}
+	return resources, nil
+}
+
+// findNetwork returns the name of a network given its name or ID prefix
+func (m *Model) findNetwork(value string) (string, error) {
+	for _, nw := range m.networks {
+		if nw.Name == value || (len(value) >= 12 && strings.HasPrefix(nw.ID, value)) {
+			return nw.Name, nil
+		}
+	}
+	return "", fmt.Errorf("network %s not found", value)
+}
+
+// createRequest validates the whole run wizard and builds the create call
+func (m *Model) createRequest(form *FormDialog, exceptID string) (createRequest, error) {
+	var req createRequest
+
+	imageRef, local, err := m.resolveImage(form.Value("image"))
+	if err != nil {
+		return req, err
+	}
+	req.pull = !local
+	if req.name = form.Value("name"); req.name != "" {
+		if err := m.validateContainerName(req.name, exceptID); err != nil {
+			return req, err
+		}
+	}
+	cmd, err := shlex.Split(form.Value("command"))
+	if err != nil {
+		return req, fmt.Errorf("invalid command: %w", err)
+	}
+	env, err := parseEnv(form.Lines("env"))
+	if err != nil {
+		return req, err
+	}
+	exposed, bindings, err := parsePortMappings(form.Value("ports"))
+	if err != nil {
+		return req, err
+	}
+	mounts, err := parseMounts(form.Value("mounts"))
+	if err != nil {
+		return req, err
+	}
+	restart, err := parseRestartPolicy(form.Value("restart"))
+	if err != nil {
+		return req, err
+	}
+	resources, err := parseLimits(form.Value("memory"), form.Value("cpus"))
+	if err != nil {
+		return req, err
+	}
+
+	autoRemove := form.Checked("autoremove")
+	if autoRemove && !restart.IsNone() {
+		return req, errors.New("a container that is removed when it exits cannot have a restart policy")
+	}
+
+	req.config = &container.Config{
+		Image:        imageRef,
+		Cmd:          cmd,
+		Env:          env,
+		ExposedPorts: exposed,
+	}
+	req.hostConfig = &container.HostConfig{
+		PortBindings:  bindings,
+		Mounts:        mounts,
+		RestartPolicy: restart,
+		AutoRemove:    autoRemove,
+		Resources:     resources,
+	}
+
+	if value := form.Value("network"); value != "" {
+		name, err := m.findNetwork(value)
+		if err != nil {
+			return req, err
+		}
+		req.hostConfig.NetworkMode = container.NetworkMode(name)
+		req.network = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{name: {}},
+		}
+	}
+
+	req.start = form.Checked("start")
+	return req, nil
+}
+
+// createContainer creates and optionally starts a container
+func (m *Model) createContainer(req createRequest) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.dockerClient.ContainerCreate(m.ctx, req.config, req.hostConfig, req.network, nil, req.name)
+		if err != nil {
+			return errorMsg{fmt.Errorf("error creating container: %w", err)}
+		}
+
+		name := req.name
+		if name == "" {
+			name = resp.ID[:12]
+		}
+		if !req.start {
+			return containerCreatedMsg{id: resp.ID, status: fmt.Sprintf("Created container %s", name)}
+		}
+		if err := m.dockerClient.ContainerStart(m.ctx, resp.ID, container.StartOptions{}); err != nil {
+			return errorMsg{fmt.Errorf("container %s was created but failed to start: %w", name, err)}
+		}
+		return containerCreatedMsg{id: resp.ID, status: fmt.Sprintf("Created and started container %s", name)}
+	}
+}
+
+// handleContainerCreated switches to the containers view and selects the new
+// container once the container list has been refreshed
+func (m *Model) handleContainerCreated(msg containerCreatedMsg) tea.Cmd {
+	m.currentView = ContainersView
+	m.selectContainerID = msg.id
+	m.selectContainerStatus = msg.status
+	return m.refreshData()
+}
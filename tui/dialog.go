@@ -2,7 +2,10 @@ package tui
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -66,3 +69,190 @@ func (c *ConfirmationDialog) Render() string {
 
 	return dialog
 }
+
+// InputDialog asks the user for a single line of text
+type InputDialog struct {
+	message string
+	input   textinput.Model
+	width   int
+	height  int
+	visible bool
+}
+
+func NewInputDialog(message, placeholder string) *InputDialog {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.Width = 50
+	input.Focus()
+
+	return &InputDialog{
+		message: message,
+		input:   input,
+		visible: true,
+	}
+}
+
+func (d *InputDialog) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+}
+
+func (d *InputDialog) SetValue(value string) {
+	d.input.SetValue(value)
+	d.input.CursorEnd()
+}
+
+func (d *InputDialog) Value() string {
+	return d.input.Value()
+}
+
+func (d *InputDialog) Hide() {
+	d.visible = false
+	d.input.Blur()
+}
+
+func (d *InputDialog) IsVisible() bool {
+	return d.visible
+}
+
+// Update forwards editing keys to the text input
+func (d *InputDialog) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return cmd
+}
+
+func (d *InputDialog) Render() string {
+	if !d.visible {
+		return ""
+	}
+
+	dialogStyle := AppStyles.Dialog.
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(Colors.BorderActive)).
+		Padding(1, 2).
+		Width(60)
+
+	content := fmt.Sprintf("%s\n\n%s\n\n%s",
+		d.message,
+		d.input.View(),
+		StyleMuted("Press Enter to confirm, Esc to cancel"))
+	dialog := dialogStyle.Render(content)
+
+	if d.width > 0 && d.height > 0 {
+		return lipgloss.Place(
+			d.width, d.height,
+			lipgloss.Center, lipgloss.Center,
+			dialog,
+			lipgloss.WithWhitespaceForeground(lipgloss.Color("238")),
+		)
+	}
+
+	return dialog
+}
+
+// PickerDialog lets the user choose one of a list of options
+type PickerDialog struct {
+	message string
+	options []string
+	cursor  int
+	width   int
+	height  int
+	visible bool
+}
+
+func NewPickerDialog(message string, options []string) *PickerDialog {
+	return &PickerDialog{
+		message: message,
+		options: options,
+		visible: true,
+	}
+}
+
+func (p *PickerDialog) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+}
+
+func (p *PickerDialog) Hide() {
+	p.visible = false
+}
+
+func (p *PickerDialog) IsVisible() bool {
+	return p.visible
+}
+
+// MoveCursor moves the selection by delta options, wrapping around
+func (p *PickerDialog) MoveCursor(delta int) {
+	if len(p.options) == 0 {
+		return
+	}
+	p.cursor = ((p.cursor+delta)%len(p.options) + len(p.options)) % len(p.options)
+}
+
+// Selected returns the highlighted option
+func (p *PickerDialog) Selected() string {
+	if p.cursor < 0 || p.cursor >= len(p.options) {
+		return ""
+	}
+	return p.options[p.cursor]
+}
+
+func (p *PickerDialog) Render() string {
+	if !p.visible {
+		return ""
+	}
+
+	dialogStyle := AppStyles.Dialog.
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(Colors.BorderActive)).
+		Padding(1, 2).
+		Width(60)
+
+	// Long lists scroll with the cursor
+	visible := len(p.options)
+	if p.height > 0 {
+		visible = min(visible, max(p.height-12, 3))
+	}
+	start := max(0, min(p.cursor-visible/2, len(p.options)-visible))
+	end := start + visible
+
+	var options strings.Builder
+	if start > 0 {
+		options.WriteString(StyleMuted(fmt.Sprintf("  ↑ %d more", start)))
+		options.WriteString("\n")
+	}
+	for i := start; i < end; i++ {
+		option := p.options[i]
+		if i > start {
+			options.WriteString("\n")
+		}
+		if i == p.cursor {
+			options.WriteString(AppStyles.TableSelected.Render("> " + option))
+		} else {
+			options.WriteString("  " + option)
+		}
+	}
+
+	if end < len(p.options) {
+		options.WriteString("\n")
+		options.WriteString(StyleMuted(fmt.Sprintf("  ↓ %d more", len(p.options)-end)))
+	}
+
+	content := fmt.Sprintf("%s\n\n%s\n\n%s",
+		p.message,
+		options.String(),
+		StyleMuted("↑/↓ to choose, Enter to confirm, Esc to cancel"))
+	dialog := dialogStyle.Render(content)
+
+	if p.width > 0 && p.height > 0 {
+		return lipgloss.Place(
+			p.width, p.height,
+			lipgloss.Center, lipgloss.Center,
+			dialog,
+			lipgloss.WithWhitespaceForeground(lipgloss.Color("238")),
+		)
+	}
+
+	return dialog
+}
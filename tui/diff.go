@@ -0,0 +1,419 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+)
+
+// diffNode is a path of a container's writable layer; nodes without a change
+// are parents implied by a changed path
+type diffNode struct {
+	name     string
+	path     string
+	change   *container.ChangeType
+	children []*diffNode
+}
+
+// underPath reports whether p is prefix or lies below it, matching whole
+// path components so /etc doesn't match /etcetera
+func underPath(p, prefix string) bool {
+	return p == prefix || strings.HasPrefix(p, strings.TrimSuffix(prefix, "/")+"/")
+}
+
+// buildDiffTree arranges the changes below the path prefix into a tree
+func buildDiffTree(changes []container.FilesystemChange, prefix string) *diffNode {
+	root := &diffNode{path: "/"}
+	index := map[string]*diffNode{"/": root}
+
+	for _, change := range changes {
+		if !underPath(change.Path, prefix) {
+			continue
+		}
+
+		node := root
+		for _, part := range strings.Split(strings.Trim(change.Path, "/"), "/") {
+			path := strings.TrimSuffix(node.path, "/") + "/" + part
+			child, exists := index[path]
+			if !exists {
+				child = &diffNode{name: part, path: path}
+				index[path] = child
+				node.children = append(node.children, child)
+			}
+			node = child
+		}
+		kind := change.Kind
+		node.change = &kind
+	}
+
+	sortDiffTree(root)
+	return root
+}
+
+func sortDiffTree(node *diffNode) {
+	sort.Slice(node.children, func(i, j int) bool {
+		return node.children[i].name < node.children[j].name
+	})
+	for _, child := range node.children {
+		sortDiffTree(child)
+	}
+}
+
+// diffRow is one rendered line of the tree
+type diffRow struct {
+	node  *diffNode
+	depth int
+}
+
+func appendDiffRows(rows []diffRow, node *diffNode, depth int, collapsed map[string]bool) []diffRow {
+	for _, child := range node.children {
+		rows = append(rows, diffRow{node: child, depth: depth})
+		if !collapsed[child.path] {
+			rows = appendDiffRows(rows, child, depth+1, collapsed)
+		}
+	}
+	return rows
+}
+
+// DiffView shows the changes in a container's writable layer as a tree
+type DiffView struct {
+	viewport  viewport.Model
+	key       string // ID of the container
+	title     string
+	changes   []container.FilesystemChange
+	root      *diffNode
+	rows      []diffRow
+	collapsed map[string]bool
+	cursor    int
+	prefix    string
+	loaded    bool
+	err       error
+	width     int
+	height    int
+
+	// Filter prompt state
+	input     textinput.Model
+	filtering bool
+}
+
+// NewDiffView creates a new, still loading, diff view
+func NewDiffView(key, title string) *DiffView {
+	input := textinput.New()
+	input.Prompt = "prefix: "
+	input.Placeholder = "/etc"
+
+	return &DiffView{
+		viewport:  viewport.New(0, 0),
+		key:       key,
+		title:     title,
+		collapsed: make(map[string]bool),
+		input:     input,
+	}
+}
+
+// SetSize sets the diff view dimensions
+func (dv *DiffView) SetSize(width, height int) {
+	dv.width = width
+	dv.height = height
+
+	viewportHeight := height - 1 // Reserve a line for the title bar
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	dv.viewport.Width = width
+	dv.viewport.Height = viewportHeight
+	dv.refreshContent()
+}
+
+// SetChanges stores the changes and renders them
+func (dv *DiffView) SetChanges(changes []container.FilesystemChange) {
+	dv.changes = changes
+	dv.loaded = true
+	dv.err = nil
+	dv.rebuild()
+}
+
+// SetError shows an error instead of the tree
+func (dv *DiffView) SetError(err error) {
+	dv.err = err
+	dv.refreshContent()
+}
+
+// SetPrefix only shows changes below the given path prefix
+func (dv *DiffView) SetPrefix(prefix string) {
+	dv.prefix = strings.TrimSpace(prefix)
+	dv.cursor = 0
+	dv.rebuild()
+}
+
+// rebuild flattens the tree again after a collapse or filter change
+func (dv *DiffView) rebuild() {
+	if !dv.loaded {
+		return
+	}
+	dv.root = buildDiffTree(dv.changes, dv.prefix)
+	dv.rows = appendDiffRows(nil, dv.root, 0, dv.collapsed)
+	dv.cursor = min(dv.cursor, max(len(dv.rows)-1, 0))
+	dv.refreshContent()
+}
+
+// refreshContent renders the rows into the viewport, keeping the cursor visible
+func (dv *DiffView) refreshContent() {
+	switch {
+	case dv.err != nil:
+		dv.viewport.SetContent(StyleError(fmt.Sprintf("Error: %v", dv.err)))
+		return
+	case !dv.loaded:
+		dv.viewport.SetContent(StyleMuted("Loading..."))
+		return
+	case len(dv.rows) == 0:
+		dv.viewport.SetContent(StyleMuted("No changes"))
+		return
+	}
+
+	lines := make([]string, len(dv.rows))
+	for i, row := range dv.rows {
+		lines[i] = dv.renderRow(row, i == dv.cursor)
+	}
+	dv.viewport.SetContent(strings.Join(lines, "\n"))
+
+	// Scroll just enough to keep the cursor on screen
+	if dv.cursor < dv.viewport.YOffset {
+		dv.viewport.SetYOffset(dv.cursor)
+	} else if dv.cursor >= dv.viewport.YOffset+dv.viewport.Height {
+		dv.viewport.SetYOffset(dv.cursor - dv.viewport.Height + 1)
+	}
+}
+
+// renderRow styles a row by the kind of change, like `docker diff` marks them
+func (dv *DiffView) renderRow(row diffRow, selected bool) string {
+	node := row.node
+
+	toggle := "  "
+	name := node.name
+	if len(node.children) > 0 {
+		toggle = "▾ "
+		if dv.collapsed[node.path] {
+			toggle = "▸ "
+			name += fmt.Sprintf("/… (%d)", countDiffChanges(node))
+		} else {
+			name += "/"
+		}
+	}
+
+	marker, style := " ", StyleMuted
+	if node.change != nil {
+		switch *node.change {
+		case container.ChangeAdd:
+			marker, style = "A", StyleSuccess
+		case container.ChangeModify:
+			marker, style = "C", StyleWarning
+		case container.ChangeDelete:
+			marker, style = "D", StyleError
+		}
+	}
+
+	line := strings.Repeat("  ", row.depth) + toggle + marker + " " + name
+	if selected {
+		return AppStyles.TableSelected.Render(line)
+	}
+	return style(line)
+}
+
+// countDiffChanges counts the changes below a node
+func countDiffChanges(node *diffNode) int {
+	count := 0
+	for _, child := range node.children {
+		if child.change != nil {
+			count++
+		}
+		count += countDiffChanges(child)
+	}
+	return count
+}
+
+// MoveCursor moves the cursor by delta rows
+func (dv *DiffView) MoveCursor(delta int) {
+	if len(dv.rows) == 0 {
+		return
+	}
+	dv.cursor = max(0, min(len(dv.rows)-1, dv.cursor+delta))
+	dv.refreshContent()
+}
+
+// SetCollapsed collapses or expands the directory under the cursor
+func (dv *DiffView) SetCollapsed(collapsed bool) {
+	if dv.cursor < 0 || dv.cursor >= len(dv.rows) {
+		return
+	}
+	node := dv.rows[dv.cursor].node
+	if len(node.children) == 0 {
+		return
+	}
+	if collapsed {
+		dv.collapsed[node.path] = true
+	} else {
+		delete(dv.collapsed, node.path)
+	}
+	dv.rebuild()
+}
+
+// ToggleCursor collapses or expands the directory under the cursor
+func (dv *DiffView) ToggleCursor() {
+	if dv.cursor >= 0 && dv.cursor < len(dv.rows) {
+		dv.SetCollapsed(!dv.collapsed[dv.rows[dv.cursor].node.path])
+	}
+}
+
+// summary counts the shown changes by kind
+func (dv *DiffView) summary() string {
+	var added, changed, deleted int
+	for _, change := range dv.changes {
+		if !underPath(change.Path, dv.prefix) {
+			continue
+		}
+		switch change.Kind {
+		case container.ChangeAdd:
+			added++
+		case container.ChangeModify:
+			changed++
+		case container.ChangeDelete:
+			deleted++
+		}
+	}
+	return fmt.Sprintf("%d added, %d changed, %d deleted", added, changed, deleted)
+}
+
+// Render renders the title bar and the visible part of the tree
+func (dv *DiffView) Render() string {
+	status := "loading"
+	if dv.loaded {
+		status = dv.summary()
+		if dv.prefix != "" {
+			status += ", under " + dv.prefix
+		}
+	}
+
+	title := fmt.Sprintf("%s %s", StyleSubtitle(dv.title), StyleMuted("("+status+")"))
+	if dv.filtering {
+		title = dv.input.View()
+	}
+	return title + "\n" + dv.viewport.View()
+}
+
+// diffLoadedMsg carries the changes of a container's writable layer
+type diffLoadedMsg struct {
+	key     string
+	changes []container.FilesystemChange
+	err     error
+}
+
+// showDiff opens the diff view for the selected container
+func (m *Model) showDiff() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	m.diffView = NewDiffView(cont.ID, "Changes: "+strings.TrimPrefix(cont.Names[0], "/"))
+	m.diffView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = DiffViewMode
+	return m.fetchDiff(cont.ID)
+}
+
+func (m *Model) fetchDiff(containerID string) tea.Cmd {
+	return func() tea.Msg {
+		changes, err := m.dockerClient.ContainerDiff(m.ctx, containerID)
+		return diffLoadedMsg{key: containerID, changes: changes, err: err}
+	}
+}
+
+// handleDiffLoaded fills the diff view if it is still showing the same container
+func (m *Model) handleDiffLoaded(msg diffLoadedMsg) {
+	if m.diffView == nil || m.diffView.key != msg.key {
+		return
+	}
+	if msg.err != nil {
+		m.diffView.SetError(msg.err)
+		return
+	}
+	m.diffView.SetChanges(msg.changes)
+}
+
+// closeDiff leaves the diff view
+func (m *Model) closeDiff() {
+	m.diffView = nil
+	if m.currentView == DiffViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleDiffKey handles key presses while the diff view is active
+func (m *Model) handleDiffKey(msg tea.KeyMsg) tea.Cmd {
+	dv := m.diffView
+
+	if dv.filtering {
+		switch msg.String() {
+		case "enter":
+			dv.filtering = false
+			dv.input.Blur()
+			dv.SetPrefix(dv.input.Value())
+			return nil
+		case "esc":
+			dv.filtering = false
+			dv.input.Blur()
+			return nil
+		}
+		var cmd tea.Cmd
+		dv.input, cmd = dv.input.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeDiff()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc":
+		// Clear an active filter before leaving the view
+		if dv.prefix != "" {
+			dv.SetPrefix("")
+			return nil
+		}
+		m.closeDiff()
+	case "q":
+		m.closeDiff()
+	case "up", "k":
+		dv.MoveCursor(-1)
+	case "down", "j":
+		dv.MoveCursor(1)
+	case "pgup", "b":
+		dv.MoveCursor(-dv.viewport.Height)
+	case "pgdown", "f", " ":
+		dv.MoveCursor(dv.viewport.Height)
+	case "home", "g":
+		dv.MoveCursor(-len(dv.rows))
+	case "end", "G":
+		dv.MoveCursor(len(dv.rows))
+	case "enter", "tab":
+		dv.ToggleCursor()
+	case "left", "h":
+		dv.SetCollapsed(true)
+	case "right", "l":
+		dv.SetCollapsed(false)
+	case "/":
+		dv.filtering = true
+		dv.input.SetValue(dv.prefix)
+		dv.input.CursorEnd()
+		return dv.input.Focus()
+	case "r":
+		return m.fetchDiff(dv.key)
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/DefangLabs/secret-detector/pkg/scanner"
+	"github.com/DefangLabs/secret-detector/pkg/secrets"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maskedValue replaces secrets; it has a fixed length so it doesn't leak theirs
+const maskedValue = "••••••••"
+
+var (
+	// secretKeyPattern matches variable names that conventionally hold secrets,
+	// such as DB_PASSWORD or GITHUB_TOKEN, whatever their value looks like
+	secretKeyPattern = regexp.MustCompile(`(?i)(PASSW(OR)?D|PASSPHRASE|SECRET|TOKEN|CREDENTIAL|PRIVATE|SALT|(^|_)(API_?|ACCESS_?)?KEY($|_)|(^|_)(PASS|AUTH|PW)($|_))`)
+	// credentialURLPattern matches URLs with a password, such as connection strings
+	credentialURLPattern = regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`)
+)
+
+// envVar is one entry of a container's environment
+type envVar struct {
+	key    string
+	value  string
+	secret string // Type of secret detected in the value, if any
+	masked bool
+}
+
+// envSort is the order the variables are listed in
+type envSort int
+
+const (
+	sortByDefinition envSort = iota
+	sortByKey
+)
+
+var envSortNames = map[envSort]string{
+	sortByDefinition: "definition",
+	sortByKey:        "key",
+}
+
+// EnvView lists the environment variables of a container, masking the
+// values that look like secrets until they are revealed
+type EnvView struct {
+	viewport    viewport.Model
+	containerID string
+	name        string
+	vars        []envVar
+	order       []int // Indexes into vars in display order
+	sort        envSort
+	reverse     bool
+	cursor      int
+	loading     bool
+	err         error
+	width       int
+	height      int
+}
+
+// NewEnvView creates a new, still loading, environment view
+func NewEnvView(containerID, name string) *EnvView {
+	ev := &EnvView{
+		viewport:    viewport.New(0, 0),
+		containerID: containerID,
+		name:        name,
+		loading:     true,
+	}
+	ev.refreshContent()
+	return ev
+}
+
+// SetSize sets the environment view dimensions
+func (ev *EnvView) SetSize(width, height int) {
+	ev.width = width
+	ev.height = height
+
+	viewportHeight := height - 2 // Reserve lines for the title bar and column titles
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	ev.viewport.Width = width
+	ev.viewport.Height = viewportHeight
+	ev.refreshContent()
+}
+
+// SetVars replaces the variables, keeping the variables revealed so far visible
+func (ev *EnvView) SetVars(vars []envVar) {
+	revealed := map[string]bool{}
+	for _, v := range ev.vars {
+		if !v.masked {
+			revealed[v.key] = true
+		}
+	}
+	for i := range vars {
+		if revealed[vars[i].key] {
+			vars[i].masked = false
+		}
+	}
+
+	ev.vars = vars
+	ev.loading = false
+	ev.err = nil
+	ev.sortVars()
+	ev.cursor = min(ev.cursor, max(len(vars)-1, 0))
+	ev.refreshContent()
+}
+
+// SetError shows an error instead of the variables
+func (ev *EnvView) SetError(err error) {
+	ev.err = err
+	ev.loading = false
+	ev.refreshContent()
+}
+
+// CycleSort orders the variables by the next sort key
+func (ev *EnvView) CycleSort() {
+	ev.sort = (ev.sort + 1) % envSort(len(envSortNames))
+	ev.sortVars()
+	ev.refreshContent()
+}
+
+// ToggleReverse reverses the sort order
+func (ev *EnvView) ToggleReverse() {
+	ev.reverse = !ev.reverse
+	ev.sortVars()
+	ev.refreshContent()
+}
+
+func (ev *EnvView) sortVars() {
+	ev.order = make([]int, len(ev.vars))
+	for i := range ev.order {
+		ev.order[i] = i
+	}
+	sort.SliceStable(ev.order, func(i, j int) bool {
+		a, b := ev.order[i], ev.order[j]
+		if ev.reverse {
+			a, b = b, a
+		}
+		if ev.sort == sortByKey {
+			return ev.vars[a].key < ev.vars[b].key
+		}
+		return a < b
+	})
+}
+
+// selected returns the variable under the cursor, if any
+func (ev *EnvView) selected() *envVar {
+	if ev.cursor < 0 || ev.cursor >= len(ev.order) {
+		return nil
+	}
+	return &ev.vars[ev.order[ev.cursor]]
+}
+
+// ToggleMask reveals or masks the value under the cursor
+func (ev *EnvView) ToggleMask() {
+	if v := ev.selected(); v != nil {
+		v.masked = !v.masked
+		ev.refreshContent()
+	}
+}
+
+// MaskSecrets masks every detected secret again
+func (ev *EnvView) MaskSecrets() {
+	for i := range ev.vars {
+		ev.vars[i].masked = ev.vars[i].secret != ""
+	}
+	ev.refreshContent()
+}
+
+// MoveCursor moves the cursor by delta variables
+func (ev *EnvView) MoveCursor(delta int) {
+	if len(ev.order) == 0 {
+		return
+	}
+	ev.cursor = max(0, min(len(ev.order)-1, ev.cursor+delta))
+	ev.refreshContent()
+}
+
+// keyWidth sizes the key column to the longest key, up to a third of the width
+func (ev *EnvView) keyWidth() int {
+	width := len("KEY") + 2 // Room for the sort marker
+	for _, v := range ev.vars {
+		width = max(width, len(v.key))
+	}
+	return min(width, max(ev.width/3, 10))
+}
+
+// refreshContent renders the variables into the viewport, keeping the cursor visible
+func (ev *EnvView) refreshContent() {
+	switch {
+	case ev.err != nil:
+		ev.viewport.SetContent(StyleError(fmt.Sprintf("Error: %v", ev.err)))
+		return
+	case ev.loading:
+		ev.viewport.SetContent(StyleMuted("Loading..."))
+		return
+	case len(ev.vars) == 0:
+		ev.viewport.SetContent(StyleMuted("No environment variables"))
+		return
+	}
+
+	keyWidth := ev.keyWidth()
+	valueWidth := max(ev.width-keyWidth-2, 10)
+	lines := make([]string, len(ev.order))
+	for i, idx := range ev.order {
+		v := ev.vars[idx]
+		value := v.value
+		if v.masked {
+			value = maskedValue
+		}
+		suffix := ""
+		if v.secret != "" {
+			suffix = "  [" + v.secret + "]"
+		}
+		value = truncateCell(value, max(valueWidth-len(suffix), 1))
+		line := fmt.Sprintf("%-*s  %s", keyWidth, truncateCell(v.key, keyWidth), value)
+
+		switch {
+		case i == ev.cursor:
+			lines[i] = AppStyles.TableSelected.Render(line + suffix)
+		case v.secret != "":
+			lines[i] = line + StyleWarning(suffix)
+		default:
+			lines[i] = line
+		}
+	}
+	ev.viewport.SetContent(strings.Join(lines, "\n"))
+
+	// Scroll just enough to keep the cursor on screen
+	if ev.cursor < ev.viewport.YOffset {
+		ev.viewport.SetYOffset(ev.cursor)
+	} else if ev.cursor >= ev.viewport.YOffset+ev.viewport.Height {
+		ev.viewport.SetYOffset(ev.cursor - ev.viewport.Height + 1)
+	}
+}
+
+// Render renders the title bar, the column titles and the visible variables
+func (ev *EnvView) Render() string {
+	secrets := 0
+	for _, v := range ev.vars {
+		if v.secret != "" {
+			secrets++
+		}
+	}
+	status := fmt.Sprintf("%d variables, %d detected secrets, sorted by %s", len(ev.vars), secrets, envSortNames[ev.sort])
+	if ev.loading {
+		status = "loading"
+	}
+	title := fmt.Sprintf("%s %s", StyleSubtitle("Environment: "+ev.name), StyleMuted("("+status+")"))
+
+	marker := " ▲"
+	if ev.reverse {
+		marker = " ▼"
+	}
+	keyTitle, valueTitle := "KEY", "VALUE"
+	if ev.sort == sortByKey {
+		keyTitle += marker
+	} else {
+		valueTitle += " (definition order" + marker + ")"
+	}
+	header := AppStyles.TableHeader.Render(fmt.Sprintf("%-*s  %s", ev.keyWidth(), keyTitle, valueTitle))
+	return title + "\n" + header + "\n" + ev.viewport.View()
+}
+
+// envLoadedMsg carries the environment of a container with detected secrets
+type envLoadedMsg struct {
+	containerID string
+	vars        []envVar
+	err         error
+}
+
+// showEnv opens the environment view for the selected container
+func (m *Model) showEnv() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	m.envView = NewEnvView(cont.ID, strings.TrimPrefix(cont.Names[0], "/"))
+	m.envView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = EnvViewMode
+	return m.fetchEnv()
+}
+
+// fetchEnv reads the environment of the container and scans it for secrets
+func (m *Model) fetchEnv() tea.Cmd {
+	containerID := m.envView.containerID
+
+	return func() tea.Msg {
+		info, err := m.dockerClient.ContainerInspect(m.ctx, containerID)
+		if err != nil {
+			return envLoadedMsg{containerID: containerID, err: err}
+		}
+		if info.Config == nil {
+			return envLoadedMsg{containerID: containerID}
+		}
+
+		// Each variable is scanned on its own so a finding maps to its key
+		scan := scanner.NewDefaultScanner()
+		vars := make([]envVar, len(info.Config.Env))
+		for i, kv := range info.Config.Env {
+			key, value, _ := strings.Cut(kv, "=")
+			vars[i] = envVar{key: key, value: value}
+			vars[i].secret = detectSecret(scan, kv, key, value)
+			vars[i].masked = vars[i].secret != ""
+		}
+		return envLoadedMsg{containerID: containerID, vars: vars}
+	}
+}
+
+// detectSecret returns the type of secret a variable holds, or "" when it
+// looks safe to show. The detector misses plain passwords, so well-known
+// names are masked too, and a failed scan masks the value to be safe.
+func detectSecret(scan secrets.Scanner, kv, key, value string) string {
+	findings, err := scan.Scan(kv)
+	switch {
+	case err != nil:
+		return "unscanned"
+	case len(findings) > 0:
+		return findings[0].Type
+	case value == "":
+		return ""
+	case secretKeyPattern.MatchString(key):
+		return "secret name"
+	case credentialURLPattern.MatchString(value):
+		return "URL credentials"
+	}
+	return ""
+}
+
+// handleEnvLoaded fills the environment view if it is still showing the same container
+func (m *Model) handleEnvLoaded(msg envLoadedMsg) {
+	if m.envView == nil || m.envView.containerID != msg.containerID {
+		return
+	}
+	if msg.err != nil {
+		m.envView.SetError(msg.err)
+		return
+	}
+	m.envView.SetVars(msg.vars)
+}
+
+// closeEnv leaves the environment view
+func (m *Model) closeEnv() {
+	m.envView = nil
+	if m.currentView == EnvViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleEnvKey handles key presses while the environment view is active
+func (m *Model) handleEnvKey(msg tea.KeyMsg) tea.Cmd {
+	ev := m.envView
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeEnv()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc", "q":
+		m.closeEnv()
+	case "up", "k":
+		ev.MoveCursor(-1)
+	case "down", "j":
+		ev.MoveCursor(1)
+	case "pgup", "b":
+		ev.MoveCursor(-ev.viewport.Height)
+	case "pgdown", "f":
+		ev.MoveCursor(ev.viewport.Height)
+	case "home", "g":
+		ev.MoveCursor(-len(ev.vars))
+	case "end", "G":
+		ev.MoveCursor(len(ev.vars))
+	case "enter", " ":
+		ev.ToggleMask()
+	case "m":
+		ev.MaskSecrets()
+	case "s":
+		ev.CycleSort()
+	case "r":
+		ev.ToggleReverse()
+	case "R":
+		ev.loading = true
+		return m.fetchEnv()
+	}
+	return nil
+}
@@ -0,0 +1,462 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/moby/term"
+	"github.com/muesli/cancelreader"
+)
+
+// shellCandidates are tried in order when opening an interactive shell
+var shellCandidates = []string{"/bin/bash", "/bin/sh"}
+
+const (
+	// maxCommandOutput caps the output of a one-off command kept in memory; older output is dropped
+	maxCommandOutput = 1 << 20
+	// commandRefreshInterval is how often the output pane is redrawn while a command runs
+	commandRefreshInterval = 250 * time.Millisecond
+	// defaultShellTerm is used when the host terminal doesn't set $TERM
+	defaultShellTerm = "xterm-256color"
+	// shellResizeInterval is how often the terminal size is checked during a shell session
+	shellResizeInterval = 250 * time.Millisecond
+)
+
+// containerShell runs an interactive shell inside a container with a TTY.
+// It implements tea.ExecCommand so the program is suspended while it runs.
+type containerShell struct {
+	ctx          context.Context
+	dockerClient *client.Client
+	containerID  string
+	shell        string
+	exitCode     int
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// Compile time check to ensure containerShell can be run with tea.Exec
+var _ tea.ExecCommand = (*containerShell)(nil)
+
+func (s *containerShell) SetStdin(r io.Reader)  { s.stdin = r }
+func (s *containerShell) SetStdout(w io.Writer) { s.stdout = w }
+func (s *containerShell) SetStderr(w io.Writer) { s.stderr = w }
+
+// Run creates the exec instance, attaches the terminal to it and blocks until the shell exits
+func (s *containerShell) Run() error {
+	shell, err := s.detectShell()
+	if err != nil {
+		return err
+	}
+	s.shell = shell
+
+	execOptions := container.ExecOptions{
+		Tty:          true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          []string{"TERM=" + shellTerm()},
+		Cmd:          []string{shell},
+	}
+
+	inFd, inIsTerminal := term.GetFdInfo(s.stdin)
+	outFd, _ := term.GetFdInfo(s.stdout)
+	if size, err := term.GetWinsize(outFd); err == nil {
+		execOptions.ConsoleSize = &[2]uint{uint(size.Height), uint(size.Width)}
+	}
+
+	created, err := s.dockerClient.ContainerExecCreate(s.ctx, s.containerID, execOptions)
+	if err != nil {
+		return fmt.Errorf("error creating exec: %w", err)
+	}
+
+	resp, err := s.dockerClient.ContainerExecAttach(s.ctx, created.ID, container.ExecAttachOptions{
+		Tty:         true,
+		ConsoleSize: execOptions.ConsoleSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error attaching to exec: %w", err)
+	}
+	defer resp.Close()
+
+	if inIsTerminal {
+		state, err := term.SetRawTerminal(inFd)
+		if err != nil {
+			return err
+		}
+		defer term.RestoreTerminal(inFd, state)
+	}
+
+	// The stdin copy must be cancellable, otherwise it would keep reading
+	// from the terminal and swallow the first key press after returning
+	stdin, err := cancelreader.NewReader(s.stdin)
+	if err != nil {
+		return err
+	}
+	defer stdin.Close()
+
+	go func() {
+		_, _ = io.Copy(resp.Conn, stdin)
+		_ = resp.CloseWrite()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go s.followResize(created.ID, outFd, done)
+
+	_, err = io.Copy(s.stdout, resp.Reader)
+	stdin.Cancel()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	inspect, err := s.dockerClient.ContainerExecInspect(s.ctx, created.ID)
+	if err != nil {
+		return err
+	}
+	s.exitCode = inspect.ExitCode
+	return nil
+}
+
+// followResize forwards terminal size changes to the exec session until done
+// is closed. The size is polled rather than watched through SIGWINCH, which
+// doesn't exist on Windows.
+func (s *containerShell) followResize(execID string, fd uintptr, done <-chan struct{}) {
+	ticker := time.NewTicker(shellResizeInterval)
+	defer ticker.Stop()
+
+	var last term.Winsize
+	if size, err := term.GetWinsize(fd); err == nil {
+		last = *size
+	}
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		size, err := term.GetWinsize(fd)
+		if err != nil || *size == last {
+			continue
+		}
+		last = *size
+		_ = s.dockerClient.ContainerExecResize(s.ctx, execID, container.ResizeOptions{
+			Height: uint(size.Height),
+			Width:  uint(size.Width),
+		})
+	}
+}
+
+// shellTerm returns the host's $TERM, so the shell uses the same capabilities
+func shellTerm() string {
+	if value := os.Getenv("TERM"); value != "" {
+		return value
+	}
+	return defaultShellTerm
+}
+
+// detectShell returns the first available shell in the container
+func (s *containerShell) detectShell() (string, error) {
+	for _, shell := range shellCandidates {
+		if _, err := s.dockerClient.ContainerStatPath(s.ctx, s.containerID, shell); err == nil {
+			return shell, nil
+		}
+	}
+	return "", fmt.Errorf("no shell found in container (tried %s)", strings.Join(shellCandidates, ", "))
+}
+
+// shellMsg is sent when an interactive shell session ends
+type shellMsg struct {
+	name     string
+	exitCode int
+	err      error
+}
+
+// openShell suspends the TUI and opens an interactive shell in the selected container
+func (m *Model) openShell() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	if cont.State != "running" {
+		m.status = fmt.Sprintf("Container %s is not running", name)
+		return nil
+	}
+
+	shell := &containerShell{
+		ctx:          m.ctx,
+		dockerClient: m.dockerClient,
+		containerID:  cont.ID,
+	}
+	return tea.Exec(shell, func(err error) tea.Msg {
+		return shellMsg{name: name, exitCode: shell.exitCode, err: err}
+	})
+}
+
+// handleShellExit reports how the shell session ended and refreshes the tables
+func (m *Model) handleShellExit(msg shellMsg) tea.Cmd {
+	if msg.err != nil {
+		m.err = fmt.Errorf("shell in %s: %w", msg.name, msg.err)
+		m.status = ""
+	} else {
+		m.status = fmt.Sprintf("Shell session in %s ended (exit code %d)", msg.name, msg.exitCode)
+		m.err = nil
+	}
+	return m.refreshData()
+}
+
+// outputChunk is a piece of captured command output
+type outputChunk struct {
+	stderr bool
+	data   string
+}
+
+// commandOutput collects the output of a running command, keeping the last
+// maxCommandOutput bytes. It is written by the exec goroutine and read by
+// the UI to show the output as it arrives.
+type commandOutput struct {
+	mu        sync.Mutex
+	chunks    []outputChunk
+	size      int
+	truncated bool
+	finished  bool
+}
+
+// add appends a chunk, dropping the oldest output past the cap
+func (o *commandOutput) add(chunk outputChunk) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.chunks = append(o.chunks, chunk)
+	o.size += len(chunk.data)
+	for o.size > maxCommandOutput && len(o.chunks) > 0 {
+		o.truncated = true
+		first := &o.chunks[0]
+		if excess := o.size - maxCommandOutput; excess < len(first.data) {
+			// Cut at a line break so the first line shown is whole
+			cut := excess
+			if i := strings.IndexByte(first.data[excess:], '\n'); i >= 0 {
+				cut += i + 1
+			}
+			first.data = first.data[cut:]
+			o.size -= cut
+			break
+		}
+		o.size -= len(first.data)
+		o.chunks = o.chunks[1:]
+	}
+}
+
+// render returns the output collected so far, noting when older output was dropped
+func (o *commandOutput) render() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	output := renderOutputChunks(o.chunks)
+	if o.truncated {
+		output = StyleMuted(fmt.Sprintf("(output truncated, showing the last %s)", formatSize(maxCommandOutput))) + "\n" + output
+	}
+	return output
+}
+
+// finish marks the command as done so the pane stops refreshing
+func (o *commandOutput) finish() {
+	o.mu.Lock()
+	o.finished = true
+	o.mu.Unlock()
+}
+
+func (o *commandOutput) isFinished() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.finished
+}
+
+// chunkWriter records everything written to it as chunks, preserving the
+// order in which stdout and stderr output arrived
+type chunkWriter struct {
+	output *commandOutput
+	stderr bool
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	w.output.add(outputChunk{stderr: w.stderr, data: string(p)})
+	return len(p), nil
+}
+
+// renderOutputChunks joins captured output, coloring stderr
+func renderOutputChunks(chunks []outputChunk) string {
+	var out strings.Builder
+	for _, chunk := range chunks {
+		if !chunk.stderr {
+			out.WriteString(chunk.data)
+			continue
+		}
+		// Style line by line so the escape codes don't span newlines
+		lines := strings.Split(chunk.data, "\n")
+		for i, line := range lines {
+			if i > 0 {
+				out.WriteByte('\n')
+			}
+			if line != "" {
+				out.WriteString(StyleError(line))
+			}
+		}
+	}
+	return out.String()
+}
+
+// commandResultMsg carries the outcome of a one-off command
+type commandResultMsg struct {
+	id       int
+	output   *commandOutput
+	exitCode int
+	err      error
+}
+
+// commandTickMsg redraws the output of a running command
+type commandTickMsg struct {
+	id     int
+	output *commandOutput
+}
+
+func commandTick(id int, output *commandOutput) tea.Cmd {
+	return tea.Tick(commandRefreshInterval, func(time.Time) tea.Msg {
+		return commandTickMsg{id: id, output: output}
+	})
+}
+
+// promptCommand asks for a command to run in the selected container
+func (m *Model) promptCommand() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	if cont.State != "running" {
+		m.status = fmt.Sprintf("Container %s is not running", name)
+		return
+	}
+
+	target := *cont
+	m.showInput(fmt.Sprintf("Run command in '%s'", name), "e.g. env, cat /etc/hosts", "", func(command string) tea.Cmd {
+		return m.runCommand(target, command)
+	})
+}
+
+// runCommand executes a command non-interactively and shows its output in the output pane
+func (m *Model) runCommand(cont container.Summary, command string) tea.Cmd {
+	command = strings.TrimSpace(command)
+	if command == "" {
+		return nil
+	}
+
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	view := m.showOutput(fmt.Sprintf("%s $ %s", name, command))
+	view.SetStatus("running...")
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.outputCancel = cancel
+	m.commandID++
+	id := m.commandID
+
+	output := &commandOutput{}
+	run := func() tea.Msg {
+		// outputCancel only stops the command early; release it once done
+		defer cancel()
+		exitCode, err := m.execCommand(ctx, cont.ID, command, output)
+		output.finish()
+		return commandResultMsg{id: id, output: output, exitCode: exitCode, err: err}
+	}
+	return tea.Batch(run, commandTick(id, output))
+}
+
+// execCommand runs command through /bin/sh when the container has one, so
+// pipes and redirections work, and splits it on whitespace otherwise. The
+// output is collected into output as it arrives.
+func (m *Model) execCommand(ctx context.Context, containerID, command string, output *commandOutput) (int, error) {
+	cmd := strings.Fields(command)
+	if _, err := m.dockerClient.ContainerStatPath(ctx, containerID, "/bin/sh"); err == nil {
+		cmd = []string{"/bin/sh", "-c", command}
+	}
+
+	created, err := m.dockerClient.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error creating exec: %w", err)
+	}
+
+	resp, err := m.dockerClient.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("error attaching to exec: %w", err)
+	}
+	defer resp.Close()
+
+	// Close the connection when the pane is closed so StdCopy returns
+	go func() {
+		<-ctx.Done()
+		resp.Close()
+	}()
+
+	_, err = stdcopy.StdCopy(chunkWriter{output: output}, chunkWriter{output: output, stderr: true}, resp.Reader)
+	if err != nil && ctx.Err() == nil {
+		return 0, err
+	}
+
+	inspect, err := m.dockerClient.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return 0, err
+	}
+	return inspect.ExitCode, nil
+}
+
+// handleCommandTick shows the output collected so far while the command runs
+func (m *Model) handleCommandTick(msg commandTickMsg) tea.Cmd {
+	if m.outputView == nil || msg.id != m.commandID || msg.output.isFinished() {
+		return nil
+	}
+	m.outputView.SetLiveContent(msg.output.render())
+	return commandTick(msg.id, msg.output)
+}
+
+// handleCommandResult shows the output of a finished command if its pane is still open
+func (m *Model) handleCommandResult(msg commandResultMsg) {
+	if m.outputView == nil || msg.id != m.commandID {
+		return
+	}
+	m.outputCancel = nil
+
+	output := msg.output.render()
+	if output == "" {
+		output = StyleMuted("(no output)")
+	}
+	m.outputView.SetLiveContent(output)
+
+	if msg.err != nil {
+		m.outputView.SetStatus(StyleError(fmt.Sprintf("error: %v", msg.err)))
+		return
+	}
+	status := fmt.Sprintf("exit code %d", msg.exitCode)
+	if msg.exitCode != 0 {
+		status = StyleError(status)
+	}
+	m.outputView.SetStatus(status)
+}
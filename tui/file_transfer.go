@@ -0,0 +1,457 @@
+package tui
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+const (
+	// maxDirEntries caps the entries listed for a single directory
+	maxDirEntries = 5000
+	// maxTarHeaders caps how many archive entries are scanned to list a directory
+	maxTarHeaders = 50000
+	// maxPreviewSize caps how much of a file is loaded for the preview
+	maxPreviewSize = 512 * 1024
+	// statWorkers caps the concurrent stat requests made to list a directory
+	statWorkers = 8
+)
+
+// fileEntry is a direct child of a directory in the container
+type fileEntry struct {
+	name       string
+	mode       os.FileMode
+	size       int64
+	modTime    string
+	linkTarget string
+}
+
+// expandHome replaces a leading ~/ with the user's home directory
+func expandHome(p string) (string, error) {
+	if !strings.HasPrefix(p, "~/") {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, p[2:]), nil
+}
+
+// tarRoot returns the name of the archived path itself from the first entry;
+// entries below it are prefixed with it
+func tarRoot(name string) string {
+	root := strings.TrimPrefix(strings.Trim(name, "/"), "./")
+	if root == "." {
+		return ""
+	}
+	return root
+}
+
+// tarChild returns the name of an entry relative to root if it is a direct child
+func tarChild(root, name string) (string, bool) {
+	name = strings.TrimPrefix(strings.Trim(name, "/"), "./")
+	if root != "" {
+		if !strings.HasPrefix(name, root+"/") {
+			return "", false
+		}
+		name = name[len(root)+1:]
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+// listContainerDir lists the direct children of a directory. Names come from
+// `ls` in the container and details from a stat per entry, so only the
+// directory itself is read. Stopped containers and images without ls fall
+// back to scanning the directory's archive. truncated is set when the caps
+// were hit.
+func (m *Model) listContainerDir(containerID, dir string) (entries []fileEntry, truncated bool, err error) {
+	names, err := m.execListDir(containerID, dir)
+	if err != nil {
+		return m.scanContainerDir(containerID, dir)
+	}
+	if len(names) > maxDirEntries {
+		names = names[:maxDirEntries]
+		truncated = true
+	}
+
+	found := make([]bool, len(names))
+	entries = make([]fileEntry, len(names))
+	sem := make(chan struct{}, statWorkers)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// Entries removed since ls ran are skipped
+			stat, err := m.dockerClient.ContainerStatPath(m.ctx, containerID, path.Join(dir, name))
+			if err != nil {
+				return
+			}
+			found[i] = true
+			entries[i] = fileEntry{
+				name:       name,
+				mode:       stat.Mode,
+				size:       stat.Size,
+				modTime:    stat.Mtime.Local().Format("2006-01-02 15:04"),
+				linkTarget: stat.LinkTarget,
+			}
+		}()
+	}
+	wg.Wait()
+
+	listed := entries[:0]
+	for i, entry := range entries {
+		if found[i] {
+			listed = append(listed, entry)
+		}
+	}
+	return listed, truncated, nil
+}
+
+// execListDir returns the names in a directory by running ls in the container
+func (m *Model) execListDir(containerID, dir string) ([]string, error) {
+	created, err := m.dockerClient.ContainerExecCreate(m.ctx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          []string{"ls", "-A1", "--", dir},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.dockerClient.ContainerExecAttach(m.ctx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+
+	var stdout bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, io.Discard, resp.Reader); err != nil {
+		return nil, err
+	}
+
+	inspect, err := m.dockerClient.ContainerExecInspect(m.ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+	if inspect.ExitCode != 0 {
+		return nil, fmt.Errorf("ls exited with code %d", inspect.ExitCode)
+	}
+
+	var names []string
+	for _, name := range strings.Split(stdout.String(), "\n") {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// scanContainerDir lists the direct children of a directory by reading the
+// headers of its archive. The archive holds the whole subtree, so large
+// directories are cut short by the caps.
+func (m *Model) scanContainerDir(containerID, dir string) (entries []fileEntry, truncated bool, err error) {
+	reader, _, err := m.dockerClient.CopyFromContainer(m.ctx, containerID, dir)
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	tr := tar.NewReader(reader)
+	root := ""
+	for i := 0; ; i++ {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if i == 0 {
+			root = tarRoot(header.Name)
+			continue
+		}
+		if i >= maxTarHeaders || len(entries) >= maxDirEntries {
+			truncated = true
+			break
+		}
+
+		name, ok := tarChild(root, header.Name)
+		if !ok {
+			continue
+		}
+		entries = append(entries, fileEntry{
+			name:       name,
+			mode:       header.FileInfo().Mode(),
+			size:       header.Size,
+			modTime:    header.ModTime.Local().Format("2006-01-02 15:04"),
+			linkTarget: header.Linkname,
+		})
+	}
+	return entries, truncated, nil
+}
+
+// readContainerFile returns up to maxPreviewSize bytes of a file
+func (m *Model) readContainerFile(containerID, file string) (content []byte, truncated bool, err error) {
+	reader, _, err := m.dockerClient.CopyFromContainer(m.ctx, containerID, file)
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	tr := tar.NewReader(reader)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, false, err
+	}
+	if header.Typeflag != tar.TypeReg {
+		return nil, false, fmt.Errorf("%s is not a regular file", file)
+	}
+
+	content, err = io.ReadAll(io.LimitReader(tr, maxPreviewSize))
+	if err != nil {
+		return nil, false, err
+	}
+	return content, header.Size > maxPreviewSize, nil
+}
+
+// isBinary guesses whether content is not meant to be read as text
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content)
+}
+
+// downloadFromContainer copies a file or directory out of the container
+// into the host directory dest, like `docker cp`
+func (m *Model) downloadFromContainer(containerID, src, dest string) tea.Cmd {
+	return func() tea.Msg {
+		dest, err := expandHome(dest)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		reader, _, err := m.dockerClient.CopyFromContainer(m.ctx, containerID, src)
+		if err != nil {
+			return errorMsg{err}
+		}
+		defer reader.Close()
+
+		files, err := extractTar(reader, dest)
+		if err != nil {
+			return errorMsg{fmt.Errorf("error downloading %s: %w", src, err)}
+		}
+		return statusMsg(fmt.Sprintf("Downloaded %s to %s (%d files)", src, filepath.Join(dest, path.Base(src)), files))
+	}
+}
+
+// extractTar unpacks an archive below dest and returns the number of files
+// written. The archive comes from the container and is untrusted: entries are
+// opened through an os.Root and parent directories must not be symlinks, so
+// nothing can be written outside of dest.
+func extractTar(r io.Reader, dest string) (int, error) {
+	dest = filepath.Clean(dest)
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return 0, err
+	}
+	root, err := os.OpenRoot(dest)
+	if err != nil {
+		return 0, err
+	}
+	defer root.Close()
+
+	tr := tar.NewReader(r)
+	files := 0
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return files, err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if !filepath.IsLocal(name) {
+			return files, fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := mkdirNoFollow(root, name, header.FileInfo().Mode().Perm()|0o700); err != nil {
+				return files, err
+			}
+		case tar.TypeReg:
+			if err := mkdirNoFollow(root, filepath.Dir(name), 0o755); err != nil {
+				return files, err
+			}
+			if err := removeSymlink(root, name); err != nil {
+				return files, err
+			}
+			file, err := root.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, header.FileInfo().Mode().Perm())
+			if err != nil {
+				return files, err
+			}
+			_, err = io.Copy(file, tr)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return files, err
+			}
+			files++
+		case tar.TypeSymlink:
+			if err := mkdirNoFollow(root, filepath.Dir(name), 0o755); err != nil {
+				return files, err
+			}
+			if err := root.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return files, err
+			}
+			// The parents were just checked, and later entries never write
+			// through the link since mkdirNoFollow refuses it
+			if err := os.Symlink(header.Linkname, filepath.Join(dest, name)); err != nil {
+				return files, err
+			}
+			files++
+		}
+		// Devices, fifos and hard links are skipped
+	}
+}
+
+// mkdirNoFollow creates dir and its missing parents inside root, refusing to
+// descend through anything that is not a real directory, symlinks included
+func mkdirNoFollow(root *os.Root, dir string, perm os.FileMode) error {
+	if dir == "." {
+		return nil
+	}
+
+	current := ""
+	for _, part := range strings.Split(dir, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		info, err := root.Lstat(current)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			if err := root.Mkdir(current, perm); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case !info.IsDir():
+			return fmt.Errorf("refusing to extract through %s: not a directory", current)
+		}
+	}
+	return nil
+}
+
+// removeSymlink deletes name if it is a symlink, so it is replaced rather than followed
+func removeSymlink(root *os.Root, name string) error {
+	info, err := root.Lstat(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return root.Remove(name)
+	}
+	return nil
+}
+
+// uploadToContainer copies a host file or directory into a directory of the container
+func (m *Model) uploadToContainer(containerID, src, destDir string) tea.Cmd {
+	return func() tea.Msg {
+		src, err := expandHome(src)
+		if err != nil {
+			return errorMsg{err}
+		}
+		src = filepath.Clean(src)
+		if _, err := os.Lstat(src); err != nil {
+			return errorMsg{err}
+		}
+
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(writeTar(pw, src))
+		}()
+
+		err = m.dockerClient.CopyToContainer(m.ctx, containerID, destDir, pr, container.CopyToContainerOptions{})
+		pr.Close()
+		if err != nil {
+			return errorMsg{fmt.Errorf("error uploading %s: %w", src, err)}
+		}
+		return filesUploadedMsg{
+			containerID: containerID,
+			dir:         destDir,
+			status:      fmt.Sprintf("Uploaded %s to %s", src, path.Join(destDir, filepath.Base(src))),
+		}
+	}
+}
+
+// writeTar archives src with entry names starting at its base name
+func writeTar(w io.Writer, src string) error {
+	tw := tar.NewWriter(w)
+	parent := filepath.Dir(src)
+
+	err := filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(file); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(parent, file)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
@@ -0,0 +1,439 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FileBrowser lists directories inside a container and previews text files
+type FileBrowser struct {
+	viewport    viewport.Model
+	containerID string
+	name        string // Container name shown in the title
+	dir         string
+	entries     []fileEntry
+	truncated   bool
+	cursor      int
+	loading     bool
+	err         error
+	width       int
+	height      int
+
+	// Preview state
+	previewPath      string
+	previewTruncated bool
+	previewOffset    int // Listing scroll position to restore when the preview closes
+}
+
+// NewFileBrowser creates a file browser starting at dir
+func NewFileBrowser(containerID, name, dir string) *FileBrowser {
+	return &FileBrowser{
+		viewport:    viewport.New(0, 0),
+		containerID: containerID,
+		name:        name,
+		dir:         dir,
+		loading:     true,
+	}
+}
+
+// SetSize sets the file browser dimensions
+func (fb *FileBrowser) SetSize(width, height int) {
+	fb.width = width
+	fb.height = height
+
+	viewportHeight := height - 1 // Reserve a line for the title bar
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	fb.viewport.Width = width
+	fb.viewport.Height = viewportHeight
+	if fb.previewPath == "" {
+		fb.refreshContent()
+	}
+}
+
+// SetEntries shows the listing of dir, directories first
+func (fb *FileBrowser) SetEntries(dir string, entries []fileEntry, truncated bool) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].mode.IsDir() != entries[j].mode.IsDir() {
+			return entries[i].mode.IsDir()
+		}
+		return entries[i].name < entries[j].name
+	})
+
+	if dir != fb.dir {
+		fb.cursor = 0
+	}
+	fb.dir = dir
+	fb.entries = entries
+	fb.truncated = truncated
+	fb.loading = false
+	fb.err = nil
+	fb.cursor = min(fb.cursor, max(len(entries)-1, 0))
+	fb.refreshContent()
+}
+
+// SetError shows an error instead of the listing
+func (fb *FileBrowser) SetError(err error) {
+	fb.err = err
+	fb.loading = false
+	fb.refreshContent()
+}
+
+// ShowPreview shows the content of a file instead of the listing
+func (fb *FileBrowser) ShowPreview(file string, content []byte, truncated bool) {
+	fb.previewPath = file
+	fb.previewTruncated = truncated
+	fb.previewOffset = fb.viewport.YOffset
+
+	if isBinary(content) {
+		fb.viewport.SetContent(StyleMuted("Binary file, press s to download it"))
+	} else {
+		fb.viewport.SetContent(string(content))
+	}
+	fb.viewport.GotoTop()
+}
+
+// ClosePreview returns to the listing
+func (fb *FileBrowser) ClosePreview() {
+	fb.previewPath = ""
+	fb.refreshContent()
+	fb.viewport.SetYOffset(fb.previewOffset)
+}
+
+// Selected returns the entry under the cursor, if any
+func (fb *FileBrowser) Selected() *fileEntry {
+	if fb.cursor < 0 || fb.cursor >= len(fb.entries) {
+		return nil
+	}
+	return &fb.entries[fb.cursor]
+}
+
+// SelectedPath returns the container path of the entry under the cursor
+func (fb *FileBrowser) SelectedPath() string {
+	if entry := fb.Selected(); entry != nil {
+		return path.Join(fb.dir, entry.name)
+	}
+	return ""
+}
+
+// MoveCursor moves the cursor by delta entries
+func (fb *FileBrowser) MoveCursor(delta int) {
+	if len(fb.entries) == 0 {
+		return
+	}
+	fb.cursor = max(0, min(len(fb.entries)-1, fb.cursor+delta))
+	fb.refreshContent()
+}
+
+// refreshContent renders the listing into the viewport, keeping the cursor visible
+func (fb *FileBrowser) refreshContent() {
+	switch {
+	case fb.err != nil:
+		fb.viewport.SetContent(StyleError(fmt.Sprintf("Error: %v", fb.err)))
+		return
+	case fb.loading && fb.entries == nil:
+		fb.viewport.SetContent(StyleMuted("Loading..."))
+		return
+	case len(fb.entries) == 0:
+		fb.viewport.SetContent(StyleMuted("Empty directory"))
+		return
+	}
+
+	lines := make([]string, len(fb.entries))
+	for i, entry := range fb.entries {
+		name := entry.name
+		switch {
+		case entry.mode.IsDir():
+			name += "/"
+		case entry.mode&os.ModeSymlink != 0:
+			name += " -> " + entry.linkTarget
+		}
+
+		size := formatSize(entry.size)
+		if entry.mode.IsDir() {
+			size = "-"
+		}
+		line := fmt.Sprintf("%s  %8s  %s  %s", entry.mode, size, entry.modTime, name)
+
+		switch {
+		case i == fb.cursor:
+			lines[i] = AppStyles.TableSelected.Render(line)
+		case entry.mode.IsDir():
+			lines[i] = AppStyles.TableHeader.Render(line)
+		default:
+			lines[i] = line
+		}
+	}
+	fb.viewport.SetContent(strings.Join(lines, "\n"))
+
+	// Scroll just enough to keep the cursor on screen
+	if fb.cursor < fb.viewport.YOffset {
+		fb.viewport.SetYOffset(fb.cursor)
+	} else if fb.cursor >= fb.viewport.YOffset+fb.viewport.Height {
+		fb.viewport.SetYOffset(fb.cursor - fb.viewport.Height + 1)
+	}
+}
+
+// Render renders the title bar and the listing or preview
+func (fb *FileBrowser) Render() string {
+	var title string
+	if fb.previewPath != "" {
+		status := fmt.Sprintf("%.0f%%", fb.viewport.ScrollPercent()*100)
+		if fb.previewTruncated {
+			status += fmt.Sprintf(", first %s only", formatSize(maxPreviewSize))
+		}
+		title = fmt.Sprintf("%s %s", StyleSubtitle(fb.name+":"+fb.previewPath), StyleMuted("("+status+")"))
+	} else {
+		status := fmt.Sprintf("%d entries", len(fb.entries))
+		if fb.loading {
+			status = "loading"
+		} else if fb.truncated {
+			status += ", listing truncated"
+		}
+		title = fmt.Sprintf("%s %s", StyleSubtitle(fb.name+":"+fb.dir), StyleMuted("("+status+")"))
+	}
+	return title + "\n" + fb.viewport.View()
+}
+
+// filesListedMsg carries the listing of a container directory
+type filesListedMsg struct {
+	containerID string
+	dir         string
+	entries     []fileEntry
+	truncated   bool
+	err         error
+}
+
+// filePreviewMsg carries the start of a container file
+type filePreviewMsg struct {
+	containerID string
+	file        string
+	content     []byte
+	truncated   bool
+	err         error
+}
+
+// filesUploadedMsg reports a finished upload so the listing can be reloaded
+type filesUploadedMsg struct {
+	containerID string
+	dir         string
+	status      string
+}
+
+// showFiles opens the file browser for the selected container at its root directory
+func (m *Model) showFiles() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	m.fileBrowser = NewFileBrowser(cont.ID, strings.TrimPrefix(cont.Names[0], "/"), "/")
+	m.fileBrowser.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = FilesViewMode
+	return m.listFiles("/")
+}
+
+// listFiles loads a directory, following it first if it is a symlink; a
+// symlink to a file is previewed instead
+func (m *Model) listFiles(dir string) tea.Cmd {
+	containerID := m.fileBrowser.containerID
+	m.fileBrowser.loading = true
+
+	return func() tea.Msg {
+		stat, err := m.dockerClient.ContainerStatPath(m.ctx, containerID, dir)
+		if err == nil && stat.Mode&os.ModeSymlink != 0 && stat.LinkTarget != "" {
+			dir = stat.LinkTarget
+			if !path.IsAbs(dir) {
+				dir = path.Join("/", dir)
+			}
+			stat, err = m.dockerClient.ContainerStatPath(m.ctx, containerID, dir)
+		}
+		if err != nil {
+			return filesListedMsg{containerID: containerID, dir: dir, err: err}
+		}
+		if !stat.Mode.IsDir() {
+			content, truncated, err := m.readContainerFile(containerID, dir)
+			return filePreviewMsg{containerID: containerID, file: dir, content: content, truncated: truncated, err: err}
+		}
+
+		entries, truncated, err := m.listContainerDir(containerID, dir)
+		return filesListedMsg{containerID: containerID, dir: dir, entries: entries, truncated: truncated, err: err}
+	}
+}
+
+// previewFile loads the start of a file for the preview
+func (m *Model) previewFile(file string) tea.Cmd {
+	containerID := m.fileBrowser.containerID
+
+	return func() tea.Msg {
+		content, truncated, err := m.readContainerFile(containerID, file)
+		return filePreviewMsg{containerID: containerID, file: file, content: content, truncated: truncated, err: err}
+	}
+}
+
+// handleFilesListed shows a listing if the browser is still open on the same container
+func (m *Model) handleFilesListed(msg filesListedMsg) {
+	if m.fileBrowser == nil || m.fileBrowser.containerID != msg.containerID {
+		return
+	}
+	if msg.err != nil {
+		// Keep the current listing, if any, and report the failure in the footer
+		if m.fileBrowser.entries != nil {
+			m.fileBrowser.loading = false
+			m.err = msg.err
+			return
+		}
+		m.fileBrowser.SetError(msg.err)
+		return
+	}
+	m.fileBrowser.SetEntries(msg.dir, msg.entries, msg.truncated)
+}
+
+// handleFilePreview shows a preview if the browser is still open on the same container
+func (m *Model) handleFilePreview(msg filePreviewMsg) {
+	if m.fileBrowser == nil || m.fileBrowser.containerID != msg.containerID {
+		return
+	}
+	m.fileBrowser.loading = false
+	if msg.err != nil {
+		m.err = msg.err
+		return
+	}
+	m.fileBrowser.ShowPreview(msg.file, msg.content, msg.truncated)
+}
+
+// handleFilesUploaded reports an upload and reloads the directory it went to
+func (m *Model) handleFilesUploaded(msg filesUploadedMsg) tea.Cmd {
+	m.status = msg.status
+	m.err = nil
+	if m.fileBrowser == nil || m.fileBrowser.containerID != msg.containerID || m.fileBrowser.dir != msg.dir {
+		return nil
+	}
+	return m.listFiles(msg.dir)
+}
+
+// openSelectedFile enters the selected directory or previews the selected file
+func (m *Model) openSelectedFile() tea.Cmd {
+	entry := m.fileBrowser.Selected()
+	if entry == nil {
+		return nil
+	}
+	selected := m.fileBrowser.SelectedPath()
+	if entry.mode.IsDir() || entry.mode&os.ModeSymlink != 0 {
+		// listFiles resolves symlinks and previews them if they point to a file
+		return m.listFiles(selected)
+	}
+	return m.previewFile(selected)
+}
+
+// promptDownload asks where on the host the selected entry should be copied to
+func (m *Model) promptDownload() {
+	src := m.fileBrowser.previewPath
+	if src == "" {
+		src = m.fileBrowser.SelectedPath()
+	}
+	if src == "" {
+		return
+	}
+
+	containerID := m.fileBrowser.containerID
+	message := fmt.Sprintf("Download %s to host directory:", src)
+	m.showInput(message, ".", ".", func(dest string) tea.Cmd {
+		if dest == "" {
+			return nil
+		}
+		m.status = fmt.Sprintf("Downloading %s...", src)
+		return m.downloadFromContainer(containerID, src, dest)
+	})
+}
+
+// promptUpload asks which host file or directory to copy into the current directory
+func (m *Model) promptUpload() {
+	containerID, dir := m.fileBrowser.containerID, m.fileBrowser.dir
+	message := fmt.Sprintf("Upload host file or directory into %s:", dir)
+	m.showInput(message, "./file.txt", "", func(src string) tea.Cmd {
+		if src == "" {
+			return nil
+		}
+		m.status = fmt.Sprintf("Uploading %s...", src)
+		return m.uploadToContainer(containerID, src, dir)
+	})
+}
+
+// closeFiles leaves the file browser
+func (m *Model) closeFiles() {
+	m.fileBrowser = nil
+	if m.currentView == FilesViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleFilesKey handles key presses while the file browser is active
+func (m *Model) handleFilesKey(msg tea.KeyMsg) tea.Cmd {
+	fb := m.fileBrowser
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeFiles()
+		m.ticker.Stop()
+		return tea.Quit
+	case "s":
+		m.promptDownload()
+		return nil
+	}
+
+	if fb.previewPath != "" {
+		switch msg.String() {
+		case "esc", "q", "backspace", "left", "h":
+			fb.ClosePreview()
+			return nil
+		case "G", "end":
+			fb.viewport.GotoBottom()
+			return nil
+		case "home", "g":
+			fb.viewport.GotoTop()
+			return nil
+		}
+		var cmd tea.Cmd
+		fb.viewport, cmd = fb.viewport.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.closeFiles()
+	case "up", "k":
+		fb.MoveCursor(-1)
+	case "down", "j":
+		fb.MoveCursor(1)
+	case "pgup", "b":
+		fb.MoveCursor(-fb.viewport.Height)
+	case "pgdown", "f", " ":
+		fb.MoveCursor(fb.viewport.Height)
+	case "home", "g":
+		fb.MoveCursor(-len(fb.entries))
+	case "end", "G":
+		fb.MoveCursor(len(fb.entries))
+	case "enter", "right", "l":
+		return m.openSelectedFile()
+	case "backspace", "left", "h":
+		if fb.dir != "/" {
+			return m.listFiles(path.Dir(fb.dir))
+		}
+	case "/":
+		return m.listFiles("/")
+	case "r":
+		return m.listFiles(fb.dir)
+	case "u":
+		m.promptUpload()
+	}
+	return nil
+}
@@ -0,0 +1,399 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// formField is a text input, a multi-line text area when multiline is set,
+// or a checkbox when toggle is set
+type formField struct {
+	key       string
+	label     string
+	hint      string
+	input     textinput.Model
+	area      textarea.Model
+	multiline bool
+	toggle    bool
+	checked   bool
+	step      int
+}
+
+// formStep is one page of a multi-step form; validate runs before moving on
+type formStep struct {
+	title    string
+	validate func(*FormDialog) error
+}
+
+// FormDialog asks for several values at once; tab moves between fields.
+// Fields can be split into steps that are filled in one after the other.
+type FormDialog struct {
+	title   string
+	fields  []*formField
+	steps   []formStep
+	step    int
+	focus   int
+	err     string
+	width   int
+	height  int
+	visible bool
+}
+
+func NewFormDialog(title string) *FormDialog {
+	return &FormDialog{
+		title:   title,
+		visible: true,
+	}
+}
+
+// AddInput adds a text field
+func (f *FormDialog) AddInput(key, label, placeholder, value string) *FormDialog {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.Width = 40
+	input.SetValue(value)
+
+	f.fields = append(f.fields, &formField{key: key, label: label, input: input, step: f.lastStep()})
+	f.focusField(f.focus)
+	return f
+}
+
+// AddTextArea adds a multi-line text field for values that hold one item per
+// line; Enter still submits the form, Alt+Enter or Ctrl+J starts a new line
+func (f *FormDialog) AddTextArea(key, label, placeholder, value string) *FormDialog {
+	area := textarea.New()
+	area.Placeholder = placeholder
+	area.ShowLineNumbers = false
+	area.KeyMap.InsertNewline.SetKeys("alt+enter", "ctrl+j")
+	area.SetWidth(60)
+	area.SetHeight(4)
+	area.SetValue(value)
+
+	f.fields = append(f.fields, &formField{key: key, label: label, area: area, multiline: true, step: f.lastStep()})
+	f.focusField(f.focus)
+	return f
+}
+
+// AddToggle adds a checkbox field
+func (f *FormDialog) AddToggle(key, label string, checked bool) *FormDialog {
+	f.fields = append(f.fields, &formField{key: key, label: label, toggle: true, checked: checked, step: f.lastStep()})
+	f.focusField(f.focus)
+	return f
+}
+
+// AddStep starts a new step; fields added afterwards belong to it. validate,
+// if set, must pass before the form moves past the step.
+func (f *FormDialog) AddStep(title string, validate func(*FormDialog) error) *FormDialog {
+	f.steps = append(f.steps, formStep{title: title, validate: validate})
+	return f
+}
+
+func (f *FormDialog) lastStep() int {
+	return max(len(f.steps)-1, 0)
+}
+
+// NextStep validates the current step and moves to the next one. It returns
+// false on the last step, when the form is ready to be submitted.
+func (f *FormDialog) NextStep() bool {
+	if f.step >= len(f.steps) {
+		return false
+	}
+	if validate := f.steps[f.step].validate; validate != nil {
+		if err := validate(f); err != nil {
+			f.SetError(err)
+			return true
+		}
+	}
+	if f.step == len(f.steps)-1 {
+		return false
+	}
+
+	f.step++
+	f.err = ""
+	f.focusField(f.firstField())
+	return true
+}
+
+// PrevStep goes back a step; it returns false on the first step
+func (f *FormDialog) PrevStep() bool {
+	if f.step == 0 {
+		return false
+	}
+	f.step--
+	f.err = ""
+	f.focusField(f.firstField())
+	return true
+}
+
+// firstField returns the index of the first field of the current step
+func (f *FormDialog) firstField() int {
+	for i, field := range f.fields {
+		if field.step == f.step {
+			return i
+		}
+	}
+	return 0
+}
+
+// SetValue replaces the text of a field
+func (f *FormDialog) SetValue(key, value string) *FormDialog {
+	if field := f.field(key); field != nil {
+		if field.multiline {
+			field.area.SetValue(value)
+		} else {
+			field.input.SetValue(value)
+		}
+	}
+	return f
+}
+
+// SetChecked sets the state of a checkbox field
+func (f *FormDialog) SetChecked(key string, checked bool) *FormDialog {
+	if field := f.field(key); field != nil {
+		field.checked = checked
+	}
+	return f
+}
+
+// SetHint shows a short explanation under a field
+func (f *FormDialog) SetHint(key, hint string) *FormDialog {
+	if field := f.field(key); field != nil {
+		field.hint = hint
+	}
+	return f
+}
+
+func (f *FormDialog) field(key string) *formField {
+	for _, field := range f.fields {
+		if field.key == key {
+			return field
+		}
+	}
+	return nil
+}
+
+// Value returns the trimmed text of a field
+func (f *FormDialog) Value(key string) string {
+	if field := f.field(key); field != nil {
+		if field.multiline {
+			return strings.TrimSpace(field.area.Value())
+		}
+		return strings.TrimSpace(field.input.Value())
+	}
+	return ""
+}
+
+// Lines returns the non-blank lines of a multi-line field as typed, without
+// trimming, so values with surrounding spaces survive
+func (f *FormDialog) Lines(key string) []string {
+	field := f.field(key)
+	if field == nil || !field.multiline {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range strings.Split(field.area.Value(), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Checked returns the state of a checkbox field
+func (f *FormDialog) Checked(key string) bool {
+	if field := f.field(key); field != nil {
+		return field.checked
+	}
+	return false
+}
+
+// SetError shows a validation error above the fields
+func (f *FormDialog) SetError(err error) {
+	f.err = ""
+	if err != nil {
+		f.err = err.Error()
+	}
+}
+
+func (f *FormDialog) SetSize(width, height int) {
+	f.width = width
+	f.height = height
+}
+
+func (f *FormDialog) Hide() {
+	f.visible = false
+	f.focusField(-1)
+}
+
+func (f *FormDialog) IsVisible() bool {
+	return f.visible
+}
+
+// focusField focuses field i; a negative i blurs every field
+func (f *FormDialog) focusField(i int) {
+	if i >= 0 && i < len(f.fields) {
+		f.focus = i
+	}
+	for j, field := range f.fields {
+		if j == f.focus && i >= 0 {
+			field.input.Focus()
+			field.area.Focus()
+		} else {
+			field.input.Blur()
+			field.area.Blur()
+		}
+	}
+}
+
+// moveFocus moves the focus by delta fields within the current step, wrapping around
+func (f *FormDialog) moveFocus(delta int) {
+	var fields []int
+	pos := 0
+	for i, field := range f.fields {
+		if field.step != f.step {
+			continue
+		}
+		if i == f.focus {
+			pos = len(fields)
+		}
+		fields = append(fields, i)
+	}
+	if len(fields) == 0 {
+		return
+	}
+	pos = ((pos+delta)%len(fields) + len(fields)) % len(fields)
+	f.focusField(fields[pos])
+}
+
+// Update moves between fields, flips checkboxes and forwards editing keys.
+// Up and down move between the lines of a text area before leaving it.
+func (f *FormDialog) Update(msg tea.KeyMsg) tea.Cmd {
+	if len(f.fields) == 0 {
+		return nil
+	}
+	field := f.fields[f.focus]
+
+	switch msg.String() {
+	case "down":
+		if field.multiline && field.area.Line() < field.area.LineCount()-1 {
+			break
+		}
+		f.moveFocus(1)
+		return nil
+	case "up":
+		if field.multiline && field.area.Line() > 0 {
+			break
+		}
+		f.moveFocus(-1)
+		return nil
+	case "tab":
+		f.moveFocus(1)
+		return nil
+	case "shift+tab":
+		f.moveFocus(-1)
+		return nil
+	}
+
+	if field.multiline {
+		var cmd tea.Cmd
+		field.area, cmd = field.area.Update(msg)
+		return cmd
+	}
+	if field.toggle {
+		if msg.String() == " " || msg.String() == "x" {
+			field.checked = !field.checked
+		}
+		return nil
+	}
+
+	var cmd tea.Cmd
+	field.input, cmd = field.input.Update(msg)
+	return cmd
+}
+
+func (f *FormDialog) Render() string {
+	if !f.visible {
+		return ""
+	}
+
+	dialogStyle := AppStyles.Dialog.
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(Colors.BorderActive)).
+		Padding(1, 2).
+		Width(70)
+
+	var content strings.Builder
+	content.WriteString(StyleSubtitle(f.title))
+	content.WriteString("\n")
+	if len(f.steps) > 1 {
+		content.WriteString(StyleMuted(fmt.Sprintf("Step %d/%d: %s", f.step+1, len(f.steps), f.steps[f.step].title)))
+		content.WriteString("\n")
+	}
+	if f.err != "" {
+		content.WriteString(StyleError(f.err))
+		content.WriteString("\n")
+	}
+
+	for i, field := range f.fields {
+		if field.step != f.step {
+			continue
+		}
+		content.WriteString("\n")
+		label := field.label
+		if i == f.focus {
+			label = AppStyles.TableHeader.Render("> " + label)
+		} else {
+			label = "  " + label
+		}
+
+		if field.toggle {
+			box := "[ ]"
+			if field.checked {
+				box = "[x]"
+			}
+			content.WriteString(fmt.Sprintf("%s %s", label, box))
+		} else if field.multiline {
+			content.WriteString(label)
+			content.WriteString("\n")
+			content.WriteString(lipgloss.NewStyle().MarginLeft(2).Render(field.area.View()))
+		} else {
+			content.WriteString(label)
+			content.WriteString("\n  ")
+			content.WriteString(field.input.View())
+		}
+		if field.hint != "" {
+			content.WriteString("\n  ")
+			content.WriteString(StyleMuted(field.hint))
+		}
+	}
+
+	content.WriteString("\n\n")
+	switch {
+	case f.step < len(f.steps)-1 && f.step > 0:
+		content.WriteString(StyleMuted("Tab/↑/↓ to move, Space to toggle, Enter for next step, Esc to go back"))
+	case f.step < len(f.steps)-1:
+		content.WriteString(StyleMuted("Tab/↑/↓ to move, Space to toggle, Enter for next step, Esc to cancel"))
+	case f.step > 0:
+		content.WriteString(StyleMuted("Tab/↑/↓ to move, Space to toggle, Enter to submit, Esc to go back"))
+	default:
+		content.WriteString(StyleMuted("Tab/↑/↓ to move, Space to toggle, Enter to submit, Esc to cancel"))
+	}
+	dialog := dialogStyle.Render(content.String())
+
+	if f.width > 0 && f.height > 0 {
+		return lipgloss.Place(
+			f.width, f.height,
+			lipgloss.Center, lipgloss.Center,
+			dialog,
+			lipgloss.WithWhitespaceForeground(lipgloss.Color("238")),
+		)
+	}
+
+	return dialog
+}
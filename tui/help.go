@@ -35,7 +35,7 @@ func (h *HelpView) Render() string {
 		"↑/k, ↓/j         Navigate up/down in tables",
 		"←/h, →/l         Navigate left/right (future use)",
 		"Tab              Switch between views",
-		"1-4              Jump directly to view (1=Containers, 2=Images, 3=Networks, 4=Volumes)",
+		"1-5              Jump directly to view (1=Containers, 2=Images, 3=Networks, 4=Volumes, 5=Resources)",
 		"r, Ctrl+R        Refresh data",
 		"q, Ctrl+C        Quit application",
 		"?                Show/hide this help",
@@ -45,32 +45,70 @@ func (h *HelpView) Render() string {
 	content.WriteString(h.renderSection("Container Management", []string{
 		"d                Delete selected container (with confirmation)",
 		"g                Toggle grouping by Docker Compose project",
-		"L                View logs for selected container (coming soon)",
-		"Enter            Inspect selected container (coming soon)",
-		"s                Start/stop selected container (coming soon)",
+		"u                Toggle live CPU, memory, network and block IO columns",
+		"H                Show CPU, memory and network history charts for selected container",
+		"H (on group)     Show summed usage charts and a per-container breakdown for the group",
+		"L                Follow logs for selected container (Esc/q to go back)",
+		"L (on group)     Follow merged logs of every service in a compose group",
+		"/, n/N, f        In logs: regex search, next/previous match, toggle grep mode",
+		"p                In logs: toggle raw/pretty JSON lines (remembered per container)",
+		"s, u, t, T       In logs: set since/until/tail, toggle timestamps",
+		"w                In logs: export the filtered buffer to a file",
+		"T                Show processes of selected container, refreshed every tick (s: sort, r: reverse)",
+		"T (on group)     Show processes of every running service in a compose group",
+		"F                Show added/changed/deleted files of selected container (/ filters by path prefix)",
+		"b                Browse files of selected container (Enter: open/preview, s: download, u: upload)",
+		"C                Commit selected container to an image (repository, tag, author, message, changes)",
+		"n                Run a new container (image, command, env, ports, mounts, network, restart, limits)",
+		"e                Recreate selected container with an edited configuration (rolls back if it fails to start)",
+		"U                Update CPU, memory, PIDs limits and restart policy of selected container",
+		"m                Rename selected container",
+		"v                Show environment of selected container (detected secrets masked, Enter reveals)",
+		"M                Show mounts of selected container (Enter on a named volume opens it in Volumes)",
+		"w / W            Connect selected container to a network (aliases, static IP) / disconnect it",
+		"E                Export filesystem of selected container to a tar file in the background",
+		"x                Open an interactive shell (bash or sh) in selected container",
+		"!                Run a one-off command in selected container and show its output",
+		"Enter            Inspect selected container (collapsible JSON/YAML, / to search)",
+		"a                Start selected container",
+		"Ctrl+S           Stop selected container (with confirmation)",
+		"R                Restart selected container (with confirmation)",
+		"p                Pause or unpause selected container",
+		"K                Kill selected container with a chosen signal (with confirmation)",
 	}))
 
 	// Image specific
 	content.WriteString(h.renderSection("Image Management", []string{
 		"d                Delete selected image (with confirmation)",
-		"Enter            Inspect selected image (coming soon)",
+		"Enter            Inspect selected image (collapsible JSON/YAML, / to search)",
+		"n                Run a new container from selected image",
+		"I                Import a tarball as a new image in the background",
 		"p                Pull new image (coming soon)",
 	}))
 
 	// Network specific
 	content.WriteString(h.renderSection("Network Management", []string{
 		"d                Delete selected network (with confirmation)",
-		"Enter            Inspect selected network (coming soon)",
+		"Enter            Inspect selected network (collapsible JSON/YAML, / to search)",
+		"w / W            Connect a container to selected network (aliases, static IP) / disconnect one",
 		"n                Create new network (coming soon)",
 	}))
 
 	// Volume specific
 	content.WriteString(h.renderSection("Volume Management", []string{
 		"d                Delete selected volume (with confirmation)",
-		"Enter            Inspect selected volume (coming soon)",
+		"Enter            Inspect selected volume (collapsible JSON/YAML, / to search)",
 		"v                Create new volume (coming soon)",
 	}))
 
+	// Resources dashboard
+	content.WriteString(h.renderSection("Resources Dashboard", []string{
+		"s                Rank by CPU, memory, network or block IO",
+		"+/-              Average over a longer/shorter span (10s to 5m)",
+		"Enter            Jump to the selected container in the Containers view",
+		"                 Rows crossing the CPU or memory thresholds are highlighted",
+	}))
+
 	// Features
 	content.WriteString(h.renderSection("Features", []string{
 		"• Real-time updates every 5 seconds",
@@ -119,6 +119,17 @@ func (it *ImageTable) GetSelectedImage() *image.Summary {
 	return nil
 }
 
+// SelectImage moves the cursor to the image with the given ID, reporting whether it is listed
+func (it *ImageTable) SelectImage(id string) bool {
+	for i, img := range it.model.images {
+		if img.ID == id {
+			it.table.SetCursor(i)
+			return true
+		}
+	}
+	return false
+}
+
 // View returns the rendered table view
 func (it *ImageTable) View() string {
 	return it.table.View()
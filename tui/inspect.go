@@ -0,0 +1,436 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/network"
+)
+
+// InspectView shows the inspect output of a resource as a collapsible tree
+type InspectView struct {
+	viewport  viewport.Model
+	title     string
+	key       string // Kind and ID of the inspected resource
+	root      *inspectNode
+	rows      []inspectRow
+	collapsed map[string]bool
+	cursor    int
+	yaml      bool
+	err       error
+	width     int
+	height    int
+
+	// Search state
+	input     textinput.Model
+	searching bool
+	search    *regexp.Regexp
+	searchErr error
+}
+
+// NewInspectView creates a new, still loading, inspect view
+func NewInspectView(key, title string) *InspectView {
+	input := textinput.New()
+	input.Prompt = "/"
+	input.Placeholder = "regex"
+
+	return &InspectView{
+		viewport:  viewport.New(0, 0),
+		key:       key,
+		title:     title,
+		collapsed: make(map[string]bool),
+		input:     input,
+	}
+}
+
+// SetSize sets the inspect view dimensions
+func (iv *InspectView) SetSize(width, height int) {
+	iv.width = width
+	iv.height = height
+
+	viewportHeight := height - 1 // Reserve a line for the title bar
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	iv.viewport.Width = width
+	iv.viewport.Height = viewportHeight
+	iv.refreshContent()
+}
+
+// SetData parses the inspect JSON and renders it
+func (iv *InspectView) SetData(data []byte) {
+	root, err := parseInspectJSON(data)
+	if err != nil {
+		iv.SetError(err)
+		return
+	}
+	iv.root = root
+	iv.rebuild()
+}
+
+// SetError shows an error instead of the tree
+func (iv *InspectView) SetError(err error) {
+	iv.err = err
+	iv.refreshContent()
+}
+
+// rebuild flattens the tree again after a collapse or format change
+func (iv *InspectView) rebuild() {
+	if iv.root == nil {
+		return
+	}
+	iv.rows = inspectRows(iv.root, iv.yaml, iv.collapsed)
+	iv.cursor = min(iv.cursor, max(len(iv.rows)-1, 0))
+	iv.refreshContent()
+}
+
+// refreshContent renders the rows into the viewport, keeping the cursor visible
+func (iv *InspectView) refreshContent() {
+	if iv.err != nil {
+		iv.viewport.SetContent(StyleError(fmt.Sprintf("Error: %v", iv.err)))
+		return
+	}
+	if iv.root == nil {
+		iv.viewport.SetContent(StyleMuted("Loading..."))
+		return
+	}
+
+	lines := make([]string, len(iv.rows))
+	for i, row := range iv.rows {
+		lines[i] = iv.renderRow(row, i == iv.cursor)
+	}
+	iv.viewport.SetContent(strings.Join(lines, "\n"))
+
+	// Scroll just enough to keep the cursor on screen
+	if iv.cursor < iv.viewport.YOffset {
+		iv.viewport.SetYOffset(iv.cursor)
+	} else if iv.cursor >= iv.viewport.YOffset+iv.viewport.Height {
+		iv.viewport.SetYOffset(iv.cursor - iv.viewport.Height + 1)
+	}
+}
+
+// renderRow styles a single row
+func (iv *InspectView) renderRow(row inspectRow, selected bool) string {
+	plain := row.String()
+	if selected {
+		return AppStyles.TableSelected.Render(plain)
+	}
+	if iv.search != nil && iv.search.MatchString(plain) {
+		return iv.search.ReplaceAllStringFunc(plain, func(match string) string {
+			return AppStyles.LogMatch.Render(match)
+		})
+	}
+
+	indent := strings.Repeat("  ", row.indent)
+	return indent + AppStyles.TableHeader.Render(row.key) + row.text
+}
+
+// MoveCursor moves the cursor by delta rows
+func (iv *InspectView) MoveCursor(delta int) {
+	if len(iv.rows) == 0 {
+		return
+	}
+	iv.cursor = max(0, min(len(iv.rows)-1, iv.cursor+delta))
+	iv.refreshContent()
+}
+
+// ToggleCursor collapses or expands the node under the cursor
+func (iv *InspectView) ToggleCursor() {
+	if node := iv.cursorNode(); node != nil {
+		iv.setCollapsed(node, !iv.collapsed[node.path])
+	}
+}
+
+// CollapseCursor collapses the node under the cursor
+func (iv *InspectView) CollapseCursor() {
+	if node := iv.cursorNode(); node != nil {
+		iv.setCollapsed(node, true)
+	}
+}
+
+// ExpandCursor expands the node under the cursor
+func (iv *InspectView) ExpandCursor() {
+	if node := iv.cursorNode(); node != nil {
+		iv.setCollapsed(node, false)
+	}
+}
+
+// cursorNode returns the collapsible node on the cursor row, if any
+func (iv *InspectView) cursorNode() *inspectNode {
+	if iv.cursor < 0 || iv.cursor >= len(iv.rows) {
+		return nil
+	}
+	return iv.rows[iv.cursor].node
+}
+
+func (iv *InspectView) setCollapsed(node *inspectNode, collapsed bool) {
+	if collapsed {
+		iv.collapsed[node.path] = true
+	} else {
+		delete(iv.collapsed, node.path)
+	}
+	iv.rebuild()
+}
+
+// CollapseAll collapses every top-level section
+func (iv *InspectView) CollapseAll() {
+	if iv.root == nil {
+		return
+	}
+	for _, child := range iv.root.children {
+		if child.kind != inspectScalar && len(child.children) > 0 {
+			iv.collapsed[child.path] = true
+		}
+	}
+	iv.cursor = 0
+	iv.rebuild()
+}
+
+// ExpandAll expands every collapsed node
+func (iv *InspectView) ExpandAll() {
+	iv.collapsed = make(map[string]bool)
+	iv.rebuild()
+}
+
+// ToggleFormat switches between JSON and YAML rendering
+func (iv *InspectView) ToggleFormat() {
+	iv.yaml = !iv.yaml
+	iv.cursor = 0
+	iv.rebuild()
+}
+
+// applySearch compiles the pattern and jumps to the first matching row
+func (iv *InspectView) applySearch(pattern string) {
+	if pattern == "" {
+		iv.search = nil
+		iv.searchErr = nil
+		iv.refreshContent()
+		return
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		iv.searchErr = err
+		return
+	}
+	iv.search = re
+	iv.searchErr = nil
+	iv.cursor = -1
+	iv.NextMatch()
+}
+
+// NextMatch moves the cursor to the next visible row matching the search, wrapping around
+func (iv *InspectView) NextMatch() {
+	iv.jumpToMatch(1)
+}
+
+// PrevMatch moves the cursor to the previous visible row matching the search, wrapping around
+func (iv *InspectView) PrevMatch() {
+	iv.jumpToMatch(-1)
+}
+
+func (iv *InspectView) jumpToMatch(step int) {
+	if iv.search == nil || len(iv.rows) == 0 {
+		return
+	}
+	for i := 1; i <= len(iv.rows); i++ {
+		row := ((iv.cursor+i*step)%len(iv.rows) + len(iv.rows)) % len(iv.rows)
+		if iv.search.MatchString(iv.rows[row].String()) {
+			iv.cursor = row
+			break
+		}
+	}
+	iv.cursor = max(iv.cursor, 0)
+	iv.refreshContent()
+}
+
+// Render renders the title bar and the visible part of the tree
+func (iv *InspectView) Render() string {
+	format := "json"
+	if iv.yaml {
+		format = "yaml"
+	}
+
+	status := format
+	if iv.searchErr != nil {
+		status += ", " + fmt.Sprintf("invalid regex: %v", iv.searchErr)
+	} else if iv.search != nil {
+		status += ", /" + iv.search.String()
+	}
+
+	title := fmt.Sprintf("%s %s", StyleSubtitle(iv.title), StyleMuted("("+status+")"))
+	if iv.searching {
+		title = iv.input.View()
+	}
+	return title + "\n" + iv.viewport.View()
+}
+
+// inspectLoadedMsg carries the inspect output of a resource
+type inspectLoadedMsg struct {
+	key  string
+	data []byte
+	err  error
+}
+
+// showInspect opens the inspect view for the selected resource of the current view
+func (m *Model) showInspect() tea.Cmd {
+	var kind, id, name string
+
+	switch m.currentView {
+	case ContainersView:
+		if cont := m.containerTable.GetSelectedContainer(); cont != nil {
+			kind, id, name = "container", cont.ID, strings.TrimPrefix(cont.Names[0], "/")
+		}
+	case ImagesView:
+		if img := m.imageTable.GetSelectedImage(); img != nil {
+			kind, id, name = "image", img.ID, img.ID[7:19]
+			if len(img.RepoTags) > 0 {
+				name = img.RepoTags[0]
+			}
+		}
+	case NetworksView:
+		if net := m.networkTable.GetSelectedNetwork(); net != nil {
+			kind, id, name = "network", net.ID, net.Name
+		}
+	case VolumesView:
+		if vol := m.volumeTable.GetSelectedVolume(); vol != nil {
+			kind, id, name = "volume", vol.Name, vol.Name
+		}
+	}
+	if id == "" {
+		return nil
+	}
+
+	key := kind + ":" + id
+	m.inspectView = NewInspectView(key, fmt.Sprintf("Inspect %s: %s", kind, name))
+	m.inspectView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = InspectViewMode
+
+	return func() tea.Msg {
+		data, err := m.fetchInspect(kind, id)
+		return inspectLoadedMsg{key: key, data: data, err: err}
+	}
+}
+
+// fetchInspect calls the inspect endpoint matching kind and returns the response as JSON
+func (m *Model) fetchInspect(kind, id string) ([]byte, error) {
+	var resp any
+	var err error
+
+	switch kind {
+	case "container":
+		resp, err = m.dockerClient.ContainerInspect(m.ctx, id)
+	case "image":
+		resp, err = m.dockerClient.ImageInspect(m.ctx, id)
+	case "network":
+		resp, err = m.dockerClient.NetworkInspect(m.ctx, id, network.InspectOptions{Verbose: true})
+	case "volume":
+		resp, err = m.dockerClient.VolumeInspect(m.ctx, id)
+	default:
+		return nil, fmt.Errorf("cannot inspect %s", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resp)
+}
+
+// handleInspectLoaded fills the inspect view if it is still showing the same resource
+func (m *Model) handleInspectLoaded(msg inspectLoadedMsg) {
+	if m.inspectView == nil || m.inspectView.key != msg.key {
+		return
+	}
+	if msg.err != nil {
+		m.inspectView.SetError(msg.err)
+		return
+	}
+	m.inspectView.SetData(msg.data)
+}
+
+// closeInspect leaves the inspect view
+func (m *Model) closeInspect() {
+	m.inspectView = nil
+	if m.currentView == InspectViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleInspectKey handles key presses while the inspect view is active
+func (m *Model) handleInspectKey(msg tea.KeyMsg) tea.Cmd {
+	iv := m.inspectView
+
+	if iv.searching {
+		switch msg.String() {
+		case "enter":
+			iv.searching = false
+			iv.input.Blur()
+			iv.applySearch(iv.input.Value())
+			return nil
+		case "esc":
+			iv.searching = false
+			iv.input.Blur()
+			return nil
+		}
+		var cmd tea.Cmd
+		iv.input, cmd = iv.input.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeInspect()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc":
+		// Clear an active search before leaving the view
+		if iv.search != nil {
+			iv.applySearch("")
+			return nil
+		}
+		m.closeInspect()
+	case "q":
+		m.closeInspect()
+	case "up", "k":
+		iv.MoveCursor(-1)
+	case "down", "j":
+		iv.MoveCursor(1)
+	case "pgup", "b":
+		iv.MoveCursor(-iv.viewport.Height)
+	case "pgdown", "f", " ":
+		iv.MoveCursor(iv.viewport.Height)
+	case "home", "g":
+		iv.MoveCursor(-len(iv.rows))
+	case "end", "G":
+		iv.MoveCursor(len(iv.rows))
+	case "enter", "tab":
+		iv.ToggleCursor()
+	case "left", "h":
+		iv.CollapseCursor()
+	case "right", "l":
+		iv.ExpandCursor()
+	case "c":
+		iv.CollapseAll()
+	case "e":
+		iv.ExpandAll()
+	case "y":
+		iv.ToggleFormat()
+	case "/":
+		iv.searching = true
+		if iv.search != nil {
+			iv.input.SetValue(iv.search.String())
+		}
+		iv.input.CursorEnd()
+		return iv.input.Focus()
+	case "n":
+		iv.NextMatch()
+	case "N":
+		iv.PrevMatch()
+	}
+	return nil
+}
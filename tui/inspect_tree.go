@@ -0,0 +1,219 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type inspectKind int
+
+const (
+	inspectScalar inspectKind = iota
+	inspectObject
+	inspectArray
+)
+
+// inspectNode is a JSON value that keeps the key order of the document
+type inspectNode struct {
+	key      string // Object key, empty for array elements and the root
+	path     string // Unique path used to remember the collapsed state
+	kind     inspectKind
+	value    any // string, json.Number, bool or nil for scalars
+	children []*inspectNode
+}
+
+// parseInspectJSON decodes a JSON document into an ordered tree
+func parseInspectJSON(data []byte) (*inspectNode, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeInspectNode(dec, "", "$")
+}
+
+func decodeInspectNode(dec *json.Decoder, key, path string) (*inspectNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &inspectNode{key: key, path: path}
+	delim, isDelim := tok.(json.Delim)
+	if !isDelim {
+		node.value = tok
+		return node, nil
+	}
+
+	switch delim {
+	case '{':
+		node.kind = inspectObject
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			childKey, _ := keyTok.(string)
+			child, err := decodeInspectNode(dec, childKey, path+"."+childKey)
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+		}
+	case '[':
+		node.kind = inspectArray
+		for i := 0; dec.More(); i++ {
+			child, err := decodeInspectNode(dec, "", fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+		}
+	}
+
+	// Consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// inspectRow is one rendered line of the tree
+type inspectRow struct {
+	node   *inspectNode // Node that is collapsed or expanded from this row, nil for closing lines
+	indent int
+	key    string // Rendered key, including quotes and separator
+	text   string // Rendered value
+}
+
+// String returns the plain text of the row
+func (r inspectRow) String() string {
+	return strings.Repeat("  ", r.indent) + r.key + r.text
+}
+
+// inspectRows flattens the tree into rows in JSON or YAML form, skipping the
+// children of collapsed nodes
+func inspectRows(root *inspectNode, yaml bool, collapsed map[string]bool) []inspectRow {
+	var rows []inspectRow
+	if yaml {
+		appendYAMLRows(&rows, root, -1, collapsed)
+	} else {
+		appendJSONRows(&rows, root, 0, true, collapsed)
+	}
+	return rows
+}
+
+func appendJSONRows(rows *[]inspectRow, node *inspectNode, indent int, last bool, collapsed map[string]bool) {
+	key := ""
+	if node.key != "" {
+		key = strconv.Quote(node.key) + ": "
+	}
+	comma := ","
+	if last {
+		comma = ""
+	}
+
+	if node.kind == inspectScalar {
+		*rows = append(*rows, inspectRow{indent: indent, key: key, text: jsonScalar(node.value) + comma})
+		return
+	}
+
+	open, closing := "{", "}"
+	if node.kind == inspectArray {
+		open, closing = "[", "]"
+	}
+
+	if len(node.children) == 0 {
+		*rows = append(*rows, inspectRow{indent: indent, key: key, text: open + closing + comma})
+		return
+	}
+	if collapsed[node.path] {
+		*rows = append(*rows, inspectRow{node: node, indent: indent, key: key, text: open + "…" + closing + comma + collapsedSummary(node)})
+		return
+	}
+
+	*rows = append(*rows, inspectRow{node: node, indent: indent, key: key, text: open})
+	for i, child := range node.children {
+		appendJSONRows(rows, child, indent+1, i == len(node.children)-1, collapsed)
+	}
+	*rows = append(*rows, inspectRow{indent: indent, text: closing + comma})
+}
+
+// appendYAMLRows renders node at indent; the root is passed an indent of -1
+// so its children start at the left margin
+func appendYAMLRows(rows *[]inspectRow, node *inspectNode, indent int, collapsed map[string]bool) {
+	for _, child := range node.children {
+		key := child.key + ":"
+		if node.kind == inspectArray {
+			key = "-"
+		}
+
+		switch {
+		case child.kind == inspectScalar:
+			*rows = append(*rows, inspectRow{indent: indent + 1, key: key + " ", text: yamlScalar(child.value)})
+		case len(child.children) == 0:
+			empty := "{}"
+			if child.kind == inspectArray {
+				empty = "[]"
+			}
+			*rows = append(*rows, inspectRow{indent: indent + 1, key: key + " ", text: empty})
+		case collapsed[child.path]:
+			*rows = append(*rows, inspectRow{node: child, indent: indent + 1, key: key + " ", text: "…" + collapsedSummary(child)})
+		default:
+			*rows = append(*rows, inspectRow{node: child, indent: indent + 1, key: key})
+			appendYAMLRows(rows, child, indent+1, collapsed)
+		}
+	}
+}
+
+// collapsedSummary describes the size of a collapsed node
+func collapsedSummary(node *inspectNode) string {
+	if node.kind == inspectArray {
+		return fmt.Sprintf("  (%d items)", len(node.children))
+	}
+	return fmt.Sprintf("  (%d keys)", len(node.children))
+}
+
+func jsonScalar(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		encoded, _ := json.Marshal(val)
+		return string(encoded)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func yamlScalar(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		if yamlNeedsQuotes(val) {
+			return strconv.Quote(val)
+		}
+		return val
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// yamlNeedsQuotes reports whether a string would be misread as plain YAML
+func yamlNeedsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return true
+	}
+	return strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.ContainsAny(s, "\n\t")
+}
@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+)
+
+// killSignals are offered by the kill signal picker, most common first
+var killSignals = []string{
+	"SIGTERM",
+	"SIGKILL",
+	"SIGHUP",
+	"SIGINT",
+	"SIGQUIT",
+	"SIGUSR1",
+	"SIGUSR2",
+}
+
+// startSelectedContainer starts the selected container if it is not running
+func (m *Model) startSelectedContainer() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	switch cont.State {
+	case "running":
+		m.status = fmt.Sprintf("Container %s is already running", cont.ID[:12])
+		return nil
+	case "paused":
+		m.status = fmt.Sprintf("Container %s is paused, press p to unpause it", cont.ID[:12])
+		return nil
+	}
+	return m.startContainer(*cont)
+}
+
+func (m *Model) startContainer(cont container.Summary) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.ContainerStart(m.ctx, cont.ID, container.StartOptions{})
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s started", cont.ID[:12]))
+	}
+}
+
+func (m *Model) showRestartConfirmation() {
+	if container := m.containerTable.GetSelectedContainer(); container != nil {
+		message := fmt.Sprintf("Are you sure you want to restart container '%s'?", container.ID[:12])
+		m.confirmDialog = NewConfirmationDialog(message)
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.confirmDialog.Show()
+		m.pendingAction = func() tea.Cmd {
+			return m.restartContainer(*container)
+		}
+	}
+}
+
+func (m *Model) restartContainer(cont container.Summary) tea.Cmd {
+	return func() tea.Msg {
+		timeout := 30
+		stopOptions := container.StopOptions{
+			Timeout: &timeout,
+		}
+
+		err := m.dockerClient.ContainerRestart(m.ctx, cont.ID, stopOptions)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s restarted", cont.ID[:12]))
+	}
+}
+
+// togglePause pauses a running container or unpauses a paused one
+func (m *Model) togglePause() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	switch cont.State {
+	case "paused":
+		return m.unpauseContainer(*cont)
+	case "running":
+		return m.pauseContainer(*cont)
+	}
+	m.status = fmt.Sprintf("Container %s is not running", cont.ID[:12])
+	return nil
+}
+
+func (m *Model) pauseContainer(cont container.Summary) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.ContainerPause(m.ctx, cont.ID)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s paused", cont.ID[:12]))
+	}
+}
+
+func (m *Model) unpauseContainer(cont container.Summary) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.ContainerUnpause(m.ctx, cont.ID)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s unpaused", cont.ID[:12]))
+	}
+}
+
+// showKillPicker asks which signal to send, then confirms before sending it
+func (m *Model) showKillPicker() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+	if cont.State != "running" && cont.State != "paused" {
+		m.status = fmt.Sprintf("Container %s is not running", cont.ID[:12])
+		return
+	}
+
+	container := *cont
+	message := fmt.Sprintf("Send which signal to container '%s'?", container.ID[:12])
+	m.showPicker(message, killSignals, func(signal string) tea.Cmd {
+		message := fmt.Sprintf("Are you sure you want to send %s to container '%s'?", signal, container.ID[:12])
+		m.confirmDialog = NewConfirmationDialog(message)
+		m.confirmDialog.SetSize(m.width, m.height)
+		m.confirmDialog.Show()
+		m.pendingAction = func() tea.Cmd {
+			return m.killContainer(container, signal)
+		}
+		return nil
+	})
+}
+
+func (m *Model) killContainer(cont container.Summary, signal string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.ContainerKill(m.ctx, cont.ID, signal)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Sent %s to container %s", signal, cont.ID[:12]))
+	}
+}
+
+// showRenamePrompt asks for a new name for the selected container
+func (m *Model) showRenamePrompt() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	container := *cont
+	current := strings.TrimPrefix(container.Names[0], "/")
+	message := fmt.Sprintf("Rename container '%s' to:", current)
+	m.showInput(message, current, current, func(name string) tea.Cmd {
+		// The API reports names with a leading slash but expects them without
+		name = strings.TrimPrefix(name, "/")
+		if name == "" || name == current {
+			return nil
+		}
+		if err := m.validateContainerName(name, container.ID); err != nil {
+			m.err = err
+			return nil
+		}
+		return m.renameContainer(container, name)
+	})
+}
+
+func (m *Model) renameContainer(cont container.Summary, name string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.ContainerRename(m.ctx, cont.ID, name)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s renamed to %s", cont.ID[:12], name))
+	}
+}
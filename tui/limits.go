@@ -0,0 +1,246 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+)
+
+// limitsInspectedMsg carries the current limits of the container to update
+type limitsInspectedMsg struct {
+	info container.InspectResponse
+}
+
+// limitChange is one setting changed by the limits form
+type limitChange struct {
+	label    string
+	from, to string
+}
+
+// showUpdateLimits loads the current limits of the selected container
+func (m *Model) showUpdateLimits() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	id := cont.ID
+	return func() tea.Msg {
+		info, err := m.dockerClient.ContainerInspect(m.ctx, id)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return limitsInspectedMsg{info: info}
+	}
+}
+
+// handleLimitsInspected opens the limits form prefilled with the current values
+func (m *Model) handleLimitsInspected(msg limitsInspectedMsg) {
+	info := msg.info
+	name := strings.TrimPrefix(info.Name, "/")
+	res := info.HostConfig.Resources
+
+	current := map[string]string{
+		"cpushares":   formatInt(res.CPUShares),
+		"cpuperiod":   formatInt(res.CPUPeriod),
+		"cpuquota":    formatInt(res.CPUQuota),
+		"memory":      formatMemoryLimit(res.Memory),
+		"reservation": formatMemoryLimit(res.MemoryReservation),
+		"pids":        "",
+		"restart":     formatRestartPolicy(info.HostConfig.RestartPolicy),
+	}
+	if res.PidsLimit != nil && *res.PidsLimit > 0 {
+		current["pids"] = formatInt(*res.PidsLimit)
+	}
+
+	form := NewFormDialog(fmt.Sprintf("Update limits of '%s'", name)).
+		AddInput("cpushares", "CPU shares", "1024", current["cpushares"]).
+		AddInput("cpuperiod", "CPU CFS period (µs)", "100000", current["cpuperiod"]).
+		AddInput("cpuquota", "CPU CFS quota (µs)", "50000", current["cpuquota"]).
+		AddInput("memory", "Memory limit", "512m", current["memory"]).
+		AddInput("reservation", "Memory reservation", "256m", current["reservation"]).
+		AddInput("pids", "PIDs limit", "100", current["pids"]).
+		AddInput("restart", "Restart policy", "no, always, unless-stopped, on-failure[:max-retries]", current["restart"])
+	for key, value := range current {
+		form.SetHint(key, "Current: "+orNotSet(value))
+	}
+	form.SetHint("cpuquota", fmt.Sprintf("Current: %s, leave empty for no quota", orNotSet(current["cpuquota"])))
+	form.SetHint("pids", fmt.Sprintf("Current: %s, leave empty for no limit", orNotSet(current["pids"])))
+
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		update, changes, err := limitsUpdate(form, info)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			return nil, errors.New("nothing changed")
+		}
+		m.showLimitsConfirmation(info.ID, name, update, changes)
+		return nil, nil
+	})
+}
+
+// limitsUpdate validates the limits form and returns the update to apply
+// with the list of changed settings
+func limitsUpdate(form *FormDialog, info container.InspectResponse) (container.UpdateConfig, []limitChange, error) {
+	var update container.UpdateConfig
+	var changes []limitChange
+	res := info.HostConfig.Resources
+
+	// Zero leaves a setting alone in ContainerUpdate, so only changed values are sent
+	parseInt := func(key, label string, from int64, allowUnset bool) (int64, error) {
+		value := form.Value(key)
+		if value == "" {
+			if from > 0 && !allowUnset {
+				return 0, fmt.Errorf("%s cannot be removed once set, only changed", strings.ToLower(label))
+			}
+			if from > 0 {
+				changes = append(changes, limitChange{label, formatInt(from), "not set"})
+				return -1, nil
+			}
+			return 0, nil
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid %s %q", strings.ToLower(label), value)
+		}
+		if n == from {
+			return 0, nil
+		}
+		changes = append(changes, limitChange{label, orNotSet(formatInt(from)), value})
+		return n, nil
+	}
+	parseMemory := func(key, label string, from int64) (int64, error) {
+		value := form.Value(key)
+		if value == "" {
+			if from > 0 {
+				return 0, fmt.Errorf("%s cannot be removed once set, only changed", strings.ToLower(label))
+			}
+			return 0, nil
+		}
+		n, err := units.RAMInBytes(value)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid %s %q", strings.ToLower(label), value)
+		}
+		if n == from {
+			return 0, nil
+		}
+		changes = append(changes, limitChange{label, orNotSet(formatMemoryLimit(from)), formatMemoryLimit(n)})
+		return n, nil
+	}
+
+	var err error
+	if update.CPUShares, err = parseInt("cpushares", "CPU shares", res.CPUShares, false); err != nil {
+		return update, nil, err
+	}
+	if update.CPUPeriod, err = parseInt("cpuperiod", "CPU CFS period", res.CPUPeriod, false); err != nil {
+		return update, nil, err
+	}
+	if update.CPUQuota, err = parseInt("cpuquota", "CPU CFS quota", res.CPUQuota, true); err != nil {
+		return update, nil, err
+	}
+	if update.Memory, err = parseMemory("memory", "Memory limit", res.Memory); err != nil {
+		return update, nil, err
+	}
+	if update.MemoryReservation, err = parseMemory("reservation", "Memory reservation", res.MemoryReservation); err != nil {
+		return update, nil, err
+	}
+
+	var pidsFrom int64
+	if res.PidsLimit != nil && *res.PidsLimit > 0 {
+		pidsFrom = *res.PidsLimit
+	}
+	pids, err := parseInt("pids", "PIDs limit", pidsFrom, true)
+	if err != nil {
+		return update, nil, err
+	}
+	if pids != 0 {
+		update.PidsLimit = &pids
+	}
+
+	if res.NanoCPUs > 0 && (update.CPUPeriod != 0 || update.CPUQuota != 0) {
+		return update, nil, errors.New("the CPU limit was set as a CPU count (--cpus), CFS period and quota cannot be changed")
+	}
+
+	// The daemon refuses a memory limit above the swap limit, keep the default ratio
+	memory := effectiveLimit(update.Memory, res.Memory)
+	if update.Memory > 0 && res.MemorySwap > 0 && update.Memory > res.MemorySwap {
+		update.MemorySwap = 2 * update.Memory
+		changes = append(changes, limitChange{"Memory+swap limit", formatMemoryLimit(res.MemorySwap), formatMemoryLimit(update.MemorySwap)})
+	}
+	reservation := effectiveLimit(update.MemoryReservation, res.MemoryReservation)
+	if memory > 0 && reservation > memory {
+		return update, nil, errors.New("memory reservation must be smaller than the memory limit")
+	}
+
+	restart, err := parseRestartPolicy(form.Value("restart"))
+	if err != nil {
+		return update, nil, err
+	}
+	if from := formatRestartPolicy(info.HostConfig.RestartPolicy); formatRestartPolicy(restart) != from {
+		if info.HostConfig.AutoRemove && !restart.IsNone() {
+			return update, nil, errors.New("a container that is removed when it exits cannot have a restart policy")
+		}
+		update.RestartPolicy = restart
+		changes = append(changes, limitChange{"Restart policy", from, formatRestartPolicy(restart)})
+	}
+
+	return update, changes, nil
+}
+
+// showLimitsConfirmation lists the changes before applying them
+func (m *Model) showLimitsConfirmation(id, name string, update container.UpdateConfig, changes []limitChange) {
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = fmt.Sprintf("%s: %s → %s", change.label, change.from, change.to)
+	}
+	message := fmt.Sprintf("Apply these changes to '%s'?\n\n%s", name, strings.Join(lines, "\n"))
+
+	m.confirmDialog = NewConfirmationDialog(message)
+	m.confirmDialog.SetSize(m.width, m.height)
+	m.confirmDialog.Show()
+	m.pendingAction = func() tea.Cmd {
+		return m.updateLimits(id, name, update, len(changes))
+	}
+}
+
+func (m *Model) updateLimits(id, name string, update container.UpdateConfig, count int) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.dockerClient.ContainerUpdate(m.ctx, id, update)
+		if err != nil {
+			return errorMsg{fmt.Errorf("error updating %s: %w", name, err)}
+		}
+		status := fmt.Sprintf("Updated %d setting(s) of %s", count, name)
+		if len(resp.Warnings) > 0 {
+			status += " (" + strings.Join(resp.Warnings, "; ") + ")"
+		}
+		return statusMsg(status)
+	}
+}
+
+func formatInt(n int64) string {
+	if n <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+func orNotSet(value string) string {
+	if value == "" {
+		return "not set"
+	}
+	return value
+}
+
+// effectiveLimit returns the new value of a setting if it changes, the old one otherwise
+func effectiveLimit(updated, current int64) int64 {
+	if updated != 0 {
+		return updated
+	}
+	return current
+}
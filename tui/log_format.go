@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Well-known field names used by zap, logrus, slog and friends
+var (
+	logTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp", "t"}
+	logLevelKeys   = []string{"level", "lvl", "severity", "@level", "levelname"}
+	logMessageKeys = []string{"msg", "message", "@message"}
+)
+
+// prettyLogLine renders a JSON log line as `time level msg key=value...`.
+// ok is false when the line is not a JSON object.
+func prettyLogLine(text string) (pretty string, level string, ok bool) {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") {
+		return "", "", false
+	}
+
+	// Numbers are kept as text so large IDs aren't rounded or shown in
+	// exponent notation
+	var fields map[string]any
+	dec := json.NewDecoder(strings.NewReader(trimmed))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil || dec.InputOffset() != int64(len(trimmed)) {
+		return "", "", false
+	}
+
+	ts := formatLogTime(takeLogField(fields, logTimeKeys))
+	level = strings.ToUpper(formatLogValue(takeLogField(fields, logLevelKeys)))
+	msg := formatLogMessage(takeLogField(fields, logMessageKeys))
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+3)
+	for _, part := range []string{ts, fmt.Sprintf("%-5s", level), msg} {
+		if strings.TrimSpace(part) != "" {
+			parts = append(parts, part)
+		}
+	}
+	for _, k := range keys {
+		parts = append(parts, k+"="+formatLogValue(fields[k]))
+	}
+
+	return strings.Join(parts, " "), level, true
+}
+
+// takeLogField removes and returns the first present field out of keys
+func takeLogField(fields map[string]any, keys []string) any {
+	for _, k := range keys {
+		if v, exists := fields[k]; exists {
+			delete(fields, k)
+			return v
+		}
+	}
+	return nil
+}
+
+// logNewlineEscaper keeps multi-line messages and stack traces on a single
+// row, which the search and scrolling logic of the log view rely on
+var logNewlineEscaper = strings.NewReplacer("\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+
+// formatLogMessage renders the message field verbatim rather than quoted,
+// with line breaks escaped
+func formatLogMessage(v any) string {
+	if text, isString := v.(string); isString {
+		return logNewlineEscaper.Replace(strings.TrimSpace(text))
+	}
+	return formatLogValue(v)
+}
+
+// formatLogTime shortens RFC3339 strings and unix epoch numbers to a time of day
+func formatLogTime(v any) string {
+	switch t := v.(type) {
+	case string:
+		if parsed, err := time.Parse(time.RFC3339Nano, t); err == nil {
+			return parsed.Local().Format("15:04:05.000")
+		}
+		return formatLogValue(t)
+	case json.Number:
+		epoch, err := t.Float64()
+		if err != nil {
+			return t.String()
+		}
+		// zap encodes epoch seconds, some loggers use milliseconds
+		if epoch > 1e12 {
+			epoch /= 1000
+		}
+		sec, frac := math.Modf(epoch)
+		return time.Unix(int64(sec), int64(frac*1e9)).Format("15:04:05.000")
+	}
+	return formatLogValue(v)
+}
+
+// formatLogValue renders a decoded JSON value compactly, quoting strings with
+// spaces or line breaks
+func formatLogValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if strings.ContainsAny(val, " \t\r\n\"") {
+			return fmt.Sprintf("%q", val)
+		}
+		return val
+	case json.Number:
+		return val.String()
+	case map[string]any, []any:
+		encoded, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// styleLogLevel colors a rendered line's level token using the active palette
+func styleLogLevel(pretty, level string) string {
+	var render func(string) string
+	switch strings.ToLower(level) {
+	case "error", "err", "fatal", "panic", "critical", "crit", "dpanic", "alert", "emerg":
+		render = StyleError
+	case "warn", "warning":
+		render = StyleWarning
+	default:
+		return pretty
+	}
+	return strings.Replace(pretty, level, render(level), 1)
+}
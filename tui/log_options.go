@@ -0,0 +1,207 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	timetypes "github.com/docker/docker/api/types/time"
+)
+
+// logOptions holds the user-controlled parts of container.LogsOptions
+type logOptions struct {
+	since      string // Relative duration ("10m") or timestamp, empty for the beginning
+	until      string // Relative duration or timestamp, empty to keep following
+	tail       string // Number of lines or "all"
+	timestamps bool   // Show the daemon timestamp in front of every line
+}
+
+// String summarises the non-default options for the title bar
+func (o logOptions) String() string {
+	var parts []string
+	if o.since != "" {
+		parts = append(parts, "since "+o.since)
+	}
+	if o.until != "" {
+		parts = append(parts, "until "+o.until)
+	}
+	if o.tail != defaultLogTail {
+		parts = append(parts, "tail "+o.tail)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(parts, ", ")
+}
+
+// logPrompt identifies what the title bar prompt is asking for
+type logPrompt int
+
+const (
+	promptNone logPrompt = iota
+	promptSearch
+	promptSince
+	promptUntil
+	promptTail
+	promptExport
+)
+
+var logPromptLabels = map[logPrompt]string{
+	promptSearch: "/",
+	promptSince:  "Since (e.g. 10m, 2h, 2025-01-02T15:04:05): ",
+	promptUntil:  "Until (e.g. 5m, 2025-01-02T15:04:05): ",
+	promptTail:   "Tail (number of lines or all): ",
+	promptExport: "Export to: ",
+}
+
+// openPrompt shows the title bar prompt prefilled with value
+func (lv *LogView) openPrompt(prompt logPrompt, value string) tea.Cmd {
+	lv.prompt = prompt
+	lv.input.Prompt = logPromptLabels[prompt]
+	lv.input.SetValue(value)
+	lv.input.CursorEnd()
+	return lv.input.Focus()
+}
+
+// closePrompt hides the title bar prompt
+func (lv *LogView) closePrompt() {
+	lv.prompt = promptNone
+	lv.input.Blur()
+	lv.input.Reset()
+}
+
+// ToggleTimestamps shows or hides the timestamp in front of every line
+func (lv *LogView) ToggleTimestamps() {
+	atBottom := lv.viewport.AtBottom()
+	lv.options.timestamps = !lv.options.timestamps
+	lv.refreshContent()
+	if atBottom {
+		lv.viewport.GotoBottom()
+	}
+}
+
+// handleLogPrompt handles key presses while the title bar prompt is open
+func (m *Model) handleLogPrompt(msg tea.KeyMsg) tea.Cmd {
+	lv := m.logView
+
+	switch msg.String() {
+	case "esc":
+		lv.closePrompt()
+		return nil
+	case "enter":
+		prompt, value := lv.prompt, strings.TrimSpace(lv.input.Value())
+		lv.closePrompt()
+		return m.submitLogPrompt(prompt, value)
+	}
+
+	var cmd tea.Cmd
+	lv.input, cmd = lv.input.Update(msg)
+	return cmd
+}
+
+// submitLogPrompt applies the value entered in the title bar prompt
+func (m *Model) submitLogPrompt(prompt logPrompt, value string) tea.Cmd {
+	lv := m.logView
+	opts := lv.options
+
+	switch prompt {
+	case promptSearch:
+		lv.applySearch(value)
+		return nil
+	case promptExport:
+		return m.exportLogs(value)
+	case promptSince, promptUntil:
+		// Validate up front so a typo doesn't surface as a stream error
+		if value != "" {
+			if _, err := timetypes.GetTimestamp(value, time.Now()); err != nil {
+				return func() tea.Msg { return errorMsg{fmt.Errorf("invalid time %q: %w", value, err)} }
+			}
+		}
+		if prompt == promptSince {
+			opts.since = value
+		} else {
+			opts.until = value
+		}
+	case promptTail:
+		if value == "" {
+			value = defaultLogTail
+		}
+		if n, err := strconv.Atoi(value); value != "all" && (err != nil || n < 0) {
+			return func() tea.Msg { return errorMsg{fmt.Errorf("invalid tail %q: expected a number or all", value)} }
+		}
+		opts.tail = value
+	}
+
+	if opts == lv.options {
+		return nil
+	}
+	lv.options = opts
+	return m.restartLogStream()
+}
+
+// restartLogStream drops the buffer and reopens the stream with the current options
+func (m *Model) restartLogStream() tea.Cmd {
+	lv := m.logView
+	lv.Close()
+	lv.lines = nil
+	lv.ended = false
+	lv.err = nil
+	lv.matchSeq = 0
+	lv.refreshContent()
+	return m.startLogStream()
+}
+
+// plainLines returns the lines currently shown, without any styling, in display order
+func (lv *LogView) plainLines() []string {
+	var lines []string
+	for _, line := range lv.lines {
+		text := line.display(lv.pretty)
+		if lv.grep && lv.search != nil && !lv.search.MatchString(text) {
+			continue
+		}
+
+		var b strings.Builder
+		if lv.options.timestamps && !line.ts.IsZero() {
+			b.WriteString(line.ts.Local().Format(time.RFC3339Nano) + " ")
+		}
+		if line.source != "" {
+			b.WriteString(line.source + " | ")
+		}
+		b.WriteString(text)
+		lines = append(lines, b.String())
+	}
+	return lines
+}
+
+// exportLogs writes the currently filtered log buffer to path in the background
+func (m *Model) exportLogs(path string) tea.Cmd {
+	if path == "" {
+		return nil
+	}
+	lines := m.logView.plainLines()
+
+	return func() tea.Msg {
+		path, err := expandHome(path)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		content := strings.Join(lines, "\n") + "\n"
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return errorMsg{fmt.Errorf("error exporting logs: %w", err)}
+		}
+		return statusMsg(fmt.Sprintf("Exported %d log lines to %s", len(lines), path))
+	}
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// defaultLogExportPath suggests a file name in the working directory for the log view title
+func defaultLogExportPath(title string) string {
+	name := strings.Trim(unsafeFileChars.ReplaceAllString(title, "-"), "-")
+	return fmt.Sprintf("%s-%s.log", name, time.Now().Format("20060102-150405"))
+}
@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// StartSearch opens the search prompt, prefilled with the active pattern
+func (lv *LogView) StartSearch() tea.Cmd {
+	pattern := ""
+	if lv.search != nil {
+		pattern = lv.search.String()
+	}
+	return lv.openPrompt(promptSearch, pattern)
+}
+
+// applySearch compiles the pattern and jumps to the first match
+func (lv *LogView) applySearch(pattern string) {
+	if pattern == "" {
+		lv.ClearSearch()
+		return
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		lv.searchErr = err
+		return
+	}
+
+	lv.search = re
+	lv.searchErr = nil
+	lv.matchSeq = 0
+	lv.refreshContent()
+	lv.NextMatch()
+}
+
+// ClearSearch removes the active search, its highlighting and grep filtering
+func (lv *LogView) ClearSearch() {
+	lv.search = nil
+	lv.searchErr = nil
+	lv.grep = false
+	lv.matchSeq = 0
+	lv.refreshContent()
+}
+
+// ToggleGrep switches between highlighting matches and hiding non-matching lines
+func (lv *LogView) ToggleGrep() {
+	if lv.search == nil {
+		return
+	}
+
+	atBottom := lv.viewport.AtBottom()
+	lv.grep = !lv.grep
+	lv.matchSeq = 0
+	lv.refreshContent()
+	if atBottom {
+		lv.viewport.GotoBottom()
+	}
+}
+
+// NextMatch scrolls to the first match after the current one, wrapping around
+func (lv *LogView) NextMatch() {
+	if len(lv.matchRows) == 0 {
+		return
+	}
+
+	// matchRow is -1 when the current line was trimmed, restarting from the top
+	i := sort.SearchInts(lv.matchRows, lv.matchRow+1)
+	if i == len(lv.matchRows) {
+		i = 0
+	}
+	lv.jumpToMatch(i)
+}
+
+// PrevMatch scrolls to the last match before the current one, wrapping around
+func (lv *LogView) PrevMatch() {
+	if len(lv.matchRows) == 0 {
+		return
+	}
+
+	i := sort.SearchInts(lv.matchRows, lv.matchRow) - 1
+	if i < 0 || lv.matchRow < 0 {
+		i = len(lv.matchRows) - 1
+	}
+	lv.jumpToMatch(i)
+}
+
+// jumpToMatch makes the i-th match the current one and scrolls it into view
+func (lv *LogView) jumpToMatch(i int) {
+	lv.matchSeq = lv.matchSeqs[i]
+	lv.refreshContent()
+	lv.viewport.SetYOffset(lv.matchRow - lv.viewport.Height/2)
+}
+
+// highlight renders every match of the active search in text
+func (lv *LogView) highlight(text string, current bool) string {
+	style := AppStyles.LogMatch
+	if current {
+		style = AppStyles.LogMatchCurrent
+	}
+	return lv.search.ReplaceAllStringFunc(text, func(match string) string {
+		return style.Render(match)
+	})
+}
+
+// searchStatus describes the active search for the title bar
+func (lv *LogView) searchStatus() string {
+	if lv.searchErr != nil {
+		return " " + StyleError(fmt.Sprintf("invalid regex: %v", lv.searchErr))
+	}
+	if lv.search == nil {
+		return ""
+	}
+
+	mode := ""
+	if lv.grep {
+		mode = " [grep]"
+	}
+
+	current := 0
+	if i := sort.SearchInts(lv.matchRows, lv.matchRow); i < len(lv.matchRows) && lv.matchRows[i] == lv.matchRow {
+		current = i + 1
+	}
+
+	return " " + StyleMuted(fmt.Sprintf("/%s%s (%d/%d)", lv.search.String(), mode, current, len(lv.matchRows)))
+}
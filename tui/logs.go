@@ -0,0 +1,595 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+const (
+	// maxLogLines caps the number of lines kept in memory by the log view
+	maxLogLines = 10000
+	// maxLogBatch caps the number of lines delivered to Update in a single message
+	maxLogBatch = 500
+	// defaultLogTail is the number of existing lines fetched when a stream is opened
+	defaultLogTail = "500"
+)
+
+// serviceColors are cycled through to tell services apart in merged logs
+var serviceColors = []string{"6", "3", "2", "5", "4", "14", "11", "10", "13", "12"}
+
+// logLine is a single line of container output
+type logLine struct {
+	seq    uint64 // Arrival order within the view, identifies the line across trims and reordering
+	ts     time.Time
+	source string // Prefix identifying the producing service, empty for single container logs
+	text   string
+	pretty string // Rendered form of a JSON line, empty for plain text
+	level  string // Level extracted from a JSON line
+}
+
+// display returns the text shown for the line in raw or pretty mode
+func (l logLine) display(pretty bool) string {
+	if pretty && l.pretty != "" {
+		return l.pretty
+	}
+	return l.text
+}
+
+// logSource describes one container feeding a log view
+type logSource struct {
+	containerID string
+	prefix      string
+}
+
+// LogView displays a scrollable, continuously updated log buffer
+type LogView struct {
+	viewport viewport.Model
+	key      string // Identifies the container or group, used to remember per-view settings
+	title    string
+	lines    []logLine
+	nextSeq  uint64
+	pretty   bool              // Render JSON lines as `time level msg key=value`
+	prefixes map[string]string // Rendered, padded prefix per source
+	sources  []logSource
+	options  logOptions
+	stream   *logStream
+	ended    bool
+	err      error
+	width    int
+	height   int
+
+	// Prompt shown in the title bar
+	input  textinput.Model
+	prompt logPrompt
+
+	// Search state
+	search    *regexp.Regexp // Active search, nil when none
+	searchErr error
+	grep      bool     // Hide lines that don't match the search
+	matchRows []int    // Content rows containing a match
+	matchSeqs []uint64 // Line of each entry in matchRows
+	matchSeq  uint64   // Line of the current match, 0 before the first jump
+	matchRow  int      // Row of the current match as of the last refresh, -1 when not shown
+}
+
+// NewLogView creates a new log view with the given title
+func NewLogView(key, title string, sources []logSource) *LogView {
+	return &LogView{
+		viewport: viewport.New(0, 0),
+		key:      key,
+		title:    title,
+		prefixes: renderLogPrefixes(sources),
+		sources:  sources,
+		options:  logOptions{tail: defaultLogTail},
+		input:    textinput.New(),
+		matchRow: -1,
+	}
+}
+
+// renderLogPrefixes builds an aligned, colored prefix for every named source
+func renderLogPrefixes(sources []logSource) map[string]string {
+	var names []string
+	width := 0
+	for _, src := range sources {
+		if src.prefix == "" {
+			continue
+		}
+		names = append(names, src.prefix)
+		width = max(width, lipgloss.Width(src.prefix))
+	}
+	sort.Strings(names)
+
+	prefixes := make(map[string]string, len(names))
+	for i, name := range names {
+		style := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(serviceColors[i%len(serviceColors)])).
+			Width(width)
+		prefixes[name] = style.Render(name) + " | "
+	}
+	return prefixes
+}
+
+// SetSize sets the log view dimensions
+func (lv *LogView) SetSize(width, height int) {
+	lv.width = width
+	lv.height = height
+
+	viewportHeight := height - 1 // Reserve a line for the title bar
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	lv.viewport.Width = width
+	lv.viewport.Height = viewportHeight
+	lv.refreshContent()
+}
+
+// AppendLines adds lines to the buffer in timestamp order, following the tail
+// if the view was already at the bottom
+func (lv *LogView) AppendLines(lines []logLine) {
+	atBottom := lv.viewport.AtBottom()
+
+	for _, line := range lines {
+		lv.nextSeq++
+		line.seq = lv.nextSeq
+		lv.insertLine(line)
+	}
+	if len(lv.lines) > maxLogLines {
+		lv.lines = lv.lines[len(lv.lines)-maxLogLines:]
+	}
+
+	lv.refreshContent()
+	if atBottom {
+		lv.viewport.GotoBottom()
+	}
+}
+
+// insertLine places a line after every line that is not newer than it.
+// Lines usually arrive in order, so the scan from the end is short.
+func (lv *LogView) insertLine(line logLine) {
+	i := len(lv.lines)
+	for i > 0 && line.ts.Before(lv.lines[i-1].ts) {
+		i--
+	}
+	lv.lines = append(lv.lines, logLine{})
+	copy(lv.lines[i+1:], lv.lines[i:])
+	lv.lines[i] = line
+}
+
+// refreshContent pushes the buffer into the viewport, applying the active
+// search as a filter in grep mode and as highlighting otherwise
+func (lv *LogView) refreshContent() {
+	var content strings.Builder
+	lv.matchRows = lv.matchRows[:0]
+	lv.matchSeqs = lv.matchSeqs[:0]
+	lv.matchRow = -1
+	row := 0
+	for _, line := range lv.lines {
+		text := line.display(lv.pretty)
+		matched := lv.search != nil && lv.search.MatchString(text)
+		if lv.grep && lv.search != nil && !matched {
+			continue
+		}
+
+		if row > 0 {
+			content.WriteByte('\n')
+		}
+		if lv.options.timestamps && !line.ts.IsZero() {
+			content.WriteString(StyleMuted(line.ts.Local().Format(time.RFC3339Nano)) + " ")
+		}
+		content.WriteString(lv.prefixes[line.source])
+		switch {
+		case matched:
+			// Search highlighting takes precedence over level colors
+			current := lv.matchSeq != 0 && line.seq == lv.matchSeq
+			if current {
+				lv.matchRow = row
+			}
+			lv.matchRows = append(lv.matchRows, row)
+			lv.matchSeqs = append(lv.matchSeqs, line.seq)
+			content.WriteString(lv.highlight(text, current))
+		case lv.pretty && line.level != "":
+			content.WriteString(styleLogLevel(text, line.level))
+		default:
+			content.WriteString(text)
+		}
+		row++
+	}
+	lv.viewport.SetContent(content.String())
+}
+
+// Update forwards scrolling keys to the viewport
+func (lv *LogView) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	lv.viewport, cmd = lv.viewport.Update(msg)
+	return cmd
+}
+
+// Render renders the title bar and the visible part of the log buffer
+func (lv *LogView) Render() string {
+	state := "following"
+	if lv.err != nil {
+		state = StyleError(fmt.Sprintf("stream error: %v", lv.err))
+	} else if lv.ended {
+		state = "stream ended"
+	}
+
+	mode := "raw"
+	if lv.pretty {
+		mode = "pretty"
+	}
+
+	title := fmt.Sprintf("%s %s%s",
+		StyleSubtitle("Logs: "+lv.title),
+		StyleMuted(fmt.Sprintf("(%d lines, %s, %s%s)", len(lv.lines), mode, state, lv.options.String())),
+		lv.searchStatus())
+
+	// An open prompt takes over the title bar
+	if lv.prompt != promptNone {
+		title = lv.input.View()
+	}
+
+	return title + "\n" + lv.viewport.View()
+}
+
+// TogglePretty switches between raw and pretty-printed JSON lines
+func (lv *LogView) TogglePretty() {
+	atBottom := lv.viewport.AtBottom()
+	lv.pretty = !lv.pretty
+	lv.matchSeq = 0
+	lv.refreshContent()
+	if atBottom {
+		lv.viewport.GotoBottom()
+	}
+}
+
+// Close stops the underlying log stream, if any
+func (lv *LogView) Close() {
+	if lv.stream != nil {
+		lv.stream.cancel()
+	}
+}
+
+// logStream is a running ContainerLogs request feeding lines into a channel
+type logStream struct {
+	id     int
+	lines  chan logLine
+	err    error // Set before lines is closed
+	cancel context.CancelFunc
+}
+
+// logLinesMsg carries a batch of lines read from a log stream
+type logLinesMsg struct {
+	id    int
+	lines []logLine
+}
+
+// logStreamClosedMsg is sent once a log stream has no more lines
+type logStreamClosedMsg struct {
+	id  int
+	err error
+}
+
+// wait returns a command that blocks until the next batch of lines is available
+func (s *logStream) wait() tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-s.lines
+		if !ok {
+			return logStreamClosedMsg{id: s.id, err: s.err}
+		}
+
+		// Drain whatever else is already buffered so fast producers don't
+		// cost one Update round-trip per line
+		batch := []logLine{line}
+		for len(batch) < maxLogBatch {
+			select {
+			case line, ok := <-s.lines:
+				if !ok {
+					return logLinesMsg{id: s.id, lines: batch}
+				}
+				batch = append(batch, line)
+			default:
+				return logLinesMsg{id: s.id, lines: batch}
+			}
+		}
+		return logLinesMsg{id: s.id, lines: batch}
+	}
+}
+
+// lineWriter splits timestamped output into lines and sends them to a channel
+type lineWriter struct {
+	ctx    context.Context
+	source string
+	lines  chan<- logLine
+	buf    []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := parseLogLine(w.source, strings.TrimSuffix(string(w.buf[:i]), "\r"))
+		w.buf = w.buf[i+1:]
+		if err := w.send(line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends any trailing partial line
+func (w *lineWriter) Flush() {
+	if len(w.buf) > 0 {
+		_ = w.send(parseLogLine(w.source, string(w.buf)))
+		w.buf = nil
+	}
+}
+
+func (w *lineWriter) send(line logLine) error {
+	select {
+	case w.lines <- line:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+// parseLogLine splits the RFC3339 timestamp the daemon prepends to every line
+// and pre-renders JSON lines, keeping that work off the UI goroutine
+func parseLogLine(source, raw string) logLine {
+	line := logLine{source: source, text: raw}
+	if stamp, text, found := strings.Cut(raw, " "); found {
+		if ts, err := time.Parse(time.RFC3339Nano, stamp); err == nil {
+			line.ts = ts
+			line.text = text
+		}
+	}
+	if pretty, level, ok := prettyLogLine(line.text); ok {
+		line.pretty = pretty
+		line.level = level
+	}
+	return line
+}
+
+// showLogs opens the log view for the selected container, or a merged view
+// of every container in the group when the cursor is on a compose group header
+func (m *Model) showLogs() tea.Cmd {
+	if _, _, isGroupHeader := m.getSelectedItem(); isGroupHeader {
+		if group := m.getSelectedGroup(); group != nil {
+			return m.showGroupLogs(*group)
+		}
+		return nil
+	}
+
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	return m.openLogView(cont.ID, name, []logSource{{containerID: cont.ID}})
+}
+
+// showGroupLogs opens a merged log view for all containers of a compose group,
+// prefixing each line with its service name like `docker compose logs`
+func (m *Model) showGroupLogs(group ContainerGroup) tea.Cmd {
+	if len(group.Containers) == 0 {
+		return nil
+	}
+
+	// Replicas of the same service are told apart by their container number
+	serviceCount := make(map[string]int)
+	for _, cont := range group.Containers {
+		serviceCount[getServiceName(cont)]++
+	}
+
+	sources := make([]logSource, 0, len(group.Containers))
+	for _, cont := range group.Containers {
+		prefix := getServiceName(cont)
+		if number, exists := cont.Labels["com.docker.compose.container-number"]; exists && serviceCount[prefix] > 1 {
+			prefix += "-" + number
+		}
+		sources = append(sources, logSource{containerID: cont.ID, prefix: prefix})
+	}
+
+	title := fmt.Sprintf("%s (%d containers)", group.Name, len(group.Containers))
+	return m.openLogView("group:"+group.Name, title, sources)
+}
+
+// getServiceName returns the compose service of a container, falling back to its name
+func getServiceName(cont container.Summary) string {
+	if service, exists := cont.Labels["com.docker.compose.service"]; exists {
+		return service
+	}
+	if len(cont.Names) > 0 {
+		return strings.TrimPrefix(cont.Names[0], "/")
+	}
+	return cont.ID[:12]
+}
+
+// openLogView replaces any open log view with a new one following the given sources
+func (m *Model) openLogView(key, title string, sources []logSource) tea.Cmd {
+	m.closeLogs()
+	m.logView = NewLogView(key, title, sources)
+	m.logView.pretty = m.prettyLogs[key]
+	m.logView.SetSize(m.width, m.contentHeight())
+	m.currentView = LogsView
+
+	return m.startLogStream()
+}
+
+// startLogStream begins following the logs of every source of the log view in the background
+func (m *Model) startLogStream() tea.Cmd {
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.logStreamID++
+	stream := &logStream{
+		id:     m.logStreamID,
+		lines:  make(chan logLine, maxLogBatch),
+		cancel: cancel,
+	}
+	m.logView.stream = stream
+
+	opts := m.logView.options
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	for _, src := range m.logView.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := m.copyContainerLogs(ctx, src, opts, stream.lines); err != nil {
+				errOnce.Do(func() { stream.err = err })
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(stream.lines)
+	}()
+
+	return stream.wait()
+}
+
+// copyContainerLogs follows a container's logs until the context is cancelled or the container exits
+func (m *Model) copyContainerLogs(ctx context.Context, src logSource, opts logOptions, lines chan<- logLine) error {
+	info, err := m.dockerClient.ContainerInspect(ctx, src.containerID)
+	if err != nil {
+		return err
+	}
+
+	// Timestamps are always requested so merged streams can be interleaved;
+	// opts.timestamps only controls whether they are displayed
+	reader, err := m.dockerClient.ContainerLogs(ctx, src.containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Timestamps: true,
+		Since:      opts.since,
+		Until:      opts.until,
+		Tail:       opts.tail,
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	stdout := &lineWriter{ctx: ctx, source: src.prefix, lines: lines}
+	stderr := &lineWriter{ctx: ctx, source: src.prefix, lines: lines}
+
+	// Containers with a TTY produce a raw stream; everything else is multiplexed
+	if info.Config != nil && info.Config.Tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	}
+	stdout.Flush()
+	stderr.Flush()
+
+	if ctx.Err() != nil {
+		return nil // Stream was stopped on purpose
+	}
+	return err
+}
+
+// closeLogs stops the active log stream and leaves the log view
+func (m *Model) closeLogs() {
+	if m.logView != nil {
+		m.logView.Close()
+		m.logView = nil
+	}
+	if m.currentView == LogsView {
+		m.currentView = ContainersView
+	}
+}
+
+// handleLogsKey handles key presses while the log view is active
+func (m *Model) handleLogsKey(msg tea.KeyMsg) tea.Cmd {
+	if m.logView.prompt != promptNone {
+		return m.handleLogPrompt(msg)
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeLogs()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc":
+		// Clear an active search before leaving the view
+		if m.logView.search != nil {
+			m.logView.ClearSearch()
+			return nil
+		}
+		m.closeLogs()
+		return nil
+	case "q":
+		m.closeLogs()
+		return nil
+	case "/":
+		return m.logView.StartSearch()
+	case "n":
+		m.logView.NextMatch()
+		return nil
+	case "N":
+		m.logView.PrevMatch()
+		return nil
+	case "f":
+		m.logView.ToggleGrep()
+		return nil
+	case "p":
+		m.logView.TogglePretty()
+		m.prettyLogs[m.logView.key] = m.logView.pretty
+		return nil
+	case "s":
+		return m.logView.openPrompt(promptSince, m.logView.options.since)
+	case "u":
+		return m.logView.openPrompt(promptUntil, m.logView.options.until)
+	case "t":
+		return m.logView.openPrompt(promptTail, m.logView.options.tail)
+	case "T":
+		m.logView.ToggleTimestamps()
+		return nil
+	case "w":
+		return m.logView.openPrompt(promptExport, defaultLogExportPath(m.logView.title))
+	case "G", "end":
+		m.logView.viewport.GotoBottom()
+		return nil
+	case "home":
+		m.logView.viewport.GotoTop()
+		return nil
+	}
+	return m.logView.Update(msg)
+}
+
+// handleLogLines appends a batch of streamed lines and waits for the next one
+func (m *Model) handleLogLines(msg logLinesMsg) tea.Cmd {
+	if m.logView == nil || m.logView.stream == nil || m.logView.stream.id != msg.id {
+		return nil // Stale message from a stream that has been closed
+	}
+	m.logView.AppendLines(msg.lines)
+	return m.logView.stream.wait()
+}
+
+// handleLogStreamClosed records the end of the active log stream
+func (m *Model) handleLogStreamClosed(msg logStreamClosedMsg) {
+	if m.logView == nil || m.logView.stream == nil || m.logView.stream.id != msg.id {
+		return
+	}
+	m.logView.ended = true
+	m.logView.err = msg.err
+}
@@ -21,17 +21,35 @@ const (
 	ImagesView
 	NetworksView
 	VolumesView
+	ResourcesView
 	LogsView
+	OutputViewMode
+	InspectViewMode
+	StatsViewMode
+	TopViewMode
+	DiffViewMode
+	FilesViewMode
+	EnvViewMode
+	MountsViewMode
 	HelpViewMode
 )
 
 var viewNames = map[ViewMode]string{
-	ContainersView: "Containers",
-	ImagesView:     "Images",
-	NetworksView:   "Networks",
-	VolumesView:    "Volumes",
-	LogsView:       "Logs",
-	HelpViewMode:   "Help",
+	ContainersView:  "Containers",
+	ImagesView:      "Images",
+	NetworksView:    "Networks",
+	VolumesView:     "Volumes",
+	ResourcesView:   "Resources",
+	LogsView:        "Logs",
+	OutputViewMode:  "Output",
+	InspectViewMode: "Inspect",
+	StatsViewMode:   "Stats",
+	TopViewMode:     "Processes",
+	DiffViewMode:    "Changes",
+	FilesViewMode:   "Files",
+	EnvViewMode:     "Environment",
+	MountsViewMode:  "Mounts",
+	HelpViewMode:    "Help",
 }
 
 // ContainerGroup represents a group of containers (e.g., from the same Docker Compose project)
@@ -47,13 +65,15 @@ type Model struct {
 	ctx          context.Context
 
 	// Current view
-	currentView ViewMode
+	currentView  ViewMode
+	previousView ViewMode // View to return to when a pane is closed
 
 	// Tables for different views
 	containerTable *ContainerTable
 	imageTable     *ImageTable
 	networkTable   *NetworkTable
 	volumeTable    *VolumeTable
+	resourceTable  *ResourceTable
 
 	// Data
 	containers []container.Summary
@@ -90,6 +110,67 @@ type Model struct {
 	confirmDialog *ConfirmationDialog
 	pendingAction func() tea.Cmd
 
+	// Input dialog
+	inputDialog  *InputDialog
+	pendingInput func(string) tea.Cmd
+
+	// Picker dialog
+	pickerDialog *PickerDialog
+	pendingPick  func(string) tea.Cmd
+
+	// Form dialog
+	formDialog  *FormDialog
+	pendingForm func(*FormDialog) (tea.Cmd, error)
+
+	// Background exports and imports
+	transfers  []*transfer
+	transferID int
+
+	// Image to select once the image list has been refreshed
+	selectImageID     string
+	selectImageStatus string
+
+	// Container to select once the container list has been refreshed
+	selectContainerID     string
+	selectContainerStatus string
+
+	// Output pane
+	outputView   *OutputView
+	outputCancel context.CancelFunc // Cancels the work feeding the output pane
+	commandID    int                // Incremented for every command so stale results can be dropped
+
+	// Inspect view
+	inspectView *InspectView
+
+	// Process view
+	topView *TopView
+
+	// Filesystem diff view
+	diffView *DiffView
+
+	// File browser
+	fileBrowser *FileBrowser
+
+	// Environment variables view
+	envView *EnvView
+
+	// Mounts view
+	mountsView *MountsView
+
+	// Live resource usage
+	showStats    bool
+	stats        map[string]containerStats // Latest sample per container ID
+	statsStreams map[string]*statsStream
+	statsUpdates chan statsSample
+	statsHistory map[string]*statsHistory
+	statsWindow  time.Duration
+	statsView    *StatsView
+
+	// Log viewer
+	logView     *LogView
+	logStreamID int             // Incremented for every stream so stale messages can be dropped
+	prettyLogs  map[string]bool // Pretty-print JSON logs, remembered per container or group
+
 	// Styles
 	styles *Styles
 }
@@ -110,14 +191,40 @@ type KeyMap struct {
 	Help         key.Binding
 	Delete       key.Binding
 	Stop         key.Binding
+	Start        key.Binding
+	Restart      key.Binding
+	Pause        key.Binding
+	Kill         key.Binding
+	Top          key.Binding
+	Diff         key.Binding
+	Files        key.Binding
+	Commit       key.Binding
+	Export       key.Binding
+	Import       key.Binding
+	Create       key.Binding
+	Recreate     key.Binding
+	Limits       key.Binding
+	Rename       key.Binding
+	Env          key.Binding
+	Mounts       key.Binding
+	Connect      key.Binding
+	Disconnect   key.Binding
 	Logs         key.Binding
+	Shell        key.Binding
+	RunCommand   key.Binding
 	Containers   key.Binding
 	Images       key.Binding
 	Networks     key.Binding
 	Volumes      key.Binding
+	Resources    key.Binding
+	ResourceSort key.Binding
+	SpanLonger   key.Binding
+	SpanShorter  key.Binding
 	ThemeDefault key.Binding
 	ThemeDark    key.Binding
 	ThemeLight   key.Binding
+	StatsToggle  key.Binding
+	StatsHistory key.Binding
 	GroupToggle  key.Binding
 	GroupStop    key.Binding
 	GroupStart   key.Binding
@@ -149,7 +256,7 @@ func DefaultKeyMap() KeyMap {
 		),
 		Enter: key.NewBinding(
 			key.WithKeys("enter"),
-			key.WithHelp("enter", "select"),
+			key.WithHelp("enter", "inspect"),
 		),
 		Refresh: key.NewBinding(
 			key.WithKeys("r", "ctrl+r"),
@@ -171,10 +278,90 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("ctrl+s"),
 			key.WithHelp("ctrl+s", "stop container"),
 		),
+		Start: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "start container"),
+		),
+		Restart: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "restart container"),
+		),
+		Pause: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "pause/unpause container"),
+		),
+		Kill: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "kill container"),
+		),
+		Top: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "processes"),
+		),
+		Diff: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "filesystem changes"),
+		),
+		Files: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "browse files"),
+		),
+		Commit: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "commit to image"),
+		),
+		Export: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "export filesystem"),
+		),
+		Import: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "import tarball"),
+		),
+		Create: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "run new container"),
+		),
+		Recreate: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit and recreate"),
+		),
+		Limits: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "update limits"),
+		),
+		Rename: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "rename"),
+		),
+		Env: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "environment"),
+		),
+		Mounts: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "mounts"),
+		),
+		Connect: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "connect network"),
+		),
+		Disconnect: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "disconnect network"),
+		),
 		Logs: key.NewBinding(
 			key.WithKeys("L"),
 			key.WithHelp("L", "logs"),
 		),
+		Shell: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "shell"),
+		),
+		RunCommand: key.NewBinding(
+			key.WithKeys("!"),
+			key.WithHelp("!", "run command"),
+		),
 		Containers: key.NewBinding(
 			key.WithKeys("1"),
 			key.WithHelp("1", "containers"),
@@ -191,6 +378,22 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("4"),
 			key.WithHelp("4", "volumes"),
 		),
+		Resources: key.NewBinding(
+			key.WithKeys("5"),
+			key.WithHelp("5", "resources"),
+		),
+		ResourceSort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "rank by"),
+		),
+		SpanLonger: key.NewBinding(
+			key.WithKeys("+", "="),
+			key.WithHelp("+", "longer span"),
+		),
+		SpanShorter: key.NewBinding(
+			key.WithKeys("-"),
+			key.WithHelp("-", "shorter span"),
+		),
 		ThemeDefault: key.NewBinding(
 			key.WithKeys("t", "1"),
 			key.WithHelp("t+1", "default theme"),
@@ -203,6 +406,14 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("t", "3"),
 			key.WithHelp("t+3", "light theme"),
 		),
+		StatsToggle: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "usage columns"),
+		),
+		StatsHistory: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "stats history"),
+		),
 		GroupToggle: key.NewBinding(
 			key.WithKeys("g"),
 			key.WithHelp("g", "group by compose"),
@@ -234,6 +445,12 @@ func NewModel(dockerClient *client.Client, dockerCli command.Cli) *Model {
 		keys:         DefaultKeyMap(),
 		ticker:       time.NewTicker(5 * time.Second), // TODO: allow configurable interval
 		styles:       NewStyles(),
+		prettyLogs:   make(map[string]bool),
+		stats:        make(map[string]containerStats),
+		statsStreams: make(map[string]*statsStream),
+		statsUpdates: make(chan statsSample, maxStatsBatch),
+		statsHistory: make(map[string]*statsHistory),
+		statsWindow:  DefaultStatsWindow,
 	}
 
 	m.initTables()
@@ -250,6 +467,7 @@ func (m *Model) initTables() {
 	m.imageTable = NewImageTable(m)
 	m.networkTable = NewNetworkTable(m)
 	m.volumeTable = NewVolumeTable(m)
+	m.resourceTable = NewResourceTable(m)
 }
 
 func (m *Model) SetTheme(colors ColorPalette) {
@@ -276,4 +494,5 @@ func (m *Model) refreshTableStyles() {
 	m.imageTable.RefreshStyles()
 	m.networkTable.RefreshStyles()
 	m.volumeTable.RefreshStyles()
+	m.resourceTable.RefreshStyles()
 }
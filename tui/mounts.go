@@ -0,0 +1,209 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+)
+
+var mountTitles = []string{"TYPE", "SOURCE", "DESTINATION", "MODE", "PROPAGATION"}
+
+// MountsView lists the volumes and bind mounts of a container
+type MountsView struct {
+	viewport    viewport.Model
+	containerID string
+	name        string
+	mounts      []container.MountPoint
+	cursor      int
+	width       int
+	height      int
+}
+
+// NewMountsView creates a mounts view for the given mount points
+func NewMountsView(containerID, name string, mounts []container.MountPoint) *MountsView {
+	return &MountsView{
+		viewport:    viewport.New(0, 0),
+		containerID: containerID,
+		name:        name,
+		mounts:      mounts,
+	}
+}
+
+// SetSize sets the mounts view dimensions
+func (mv *MountsView) SetSize(width, height int) {
+	mv.width = width
+	mv.height = height
+
+	viewportHeight := height - 2 // Reserve lines for the title bar and column titles
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	mv.viewport.Width = width
+	mv.viewport.Height = viewportHeight
+	mv.refreshContent()
+}
+
+// Selected returns the mount under the cursor, if any
+func (mv *MountsView) Selected() *container.MountPoint {
+	if mv.cursor < 0 || mv.cursor >= len(mv.mounts) {
+		return nil
+	}
+	return &mv.mounts[mv.cursor]
+}
+
+// MoveCursor moves the cursor by delta mounts
+func (mv *MountsView) MoveCursor(delta int) {
+	if len(mv.mounts) == 0 {
+		return
+	}
+	mv.cursor = max(0, min(len(mv.mounts)-1, mv.cursor+delta))
+	mv.refreshContent()
+}
+
+// mountCells returns the cells of a mount row; volumes are shown by name
+func mountCells(mp container.MountPoint) []string {
+	source := mp.Source
+	if mp.Type == mount.TypeVolume && mp.Name != "" {
+		source = mp.Name
+	}
+	mode := "RO"
+	if mp.RW {
+		mode = "RW"
+	}
+	propagation := string(mp.Propagation)
+	if propagation == "" {
+		propagation = "-"
+	}
+	return []string{string(mp.Type), source, mp.Destination, mode, propagation}
+}
+
+// columnWidths sizes the columns to their widest value; the source column
+// takes whatever width is left
+func (mv *MountsView) columnWidths() []int {
+	widths := make([]int, len(mountTitles))
+	for i, title := range mountTitles {
+		widths[i] = len(title)
+	}
+	for _, mp := range mv.mounts {
+		for i, cell := range mountCells(mp) {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+
+	// Give the source column the rest, shrinking it first when space runs out
+	used := 0
+	for i, w := range widths {
+		if i != 1 {
+			used += w
+		}
+	}
+	used += 2 * (len(widths) - 1)
+	widths[1] = max(10, min(widths[1], mv.width-used))
+	return widths
+}
+
+// refreshContent renders the mounts into the viewport, keeping the cursor visible
+func (mv *MountsView) refreshContent() {
+	if len(mv.mounts) == 0 {
+		mv.viewport.SetContent(StyleMuted("No volumes or bind mounts"))
+		return
+	}
+
+	widths := mv.columnWidths()
+	lines := make([]string, len(mv.mounts))
+	for i, mp := range mv.mounts {
+		line := formatProcessRow(mountCells(mp), widths)
+		switch {
+		case i == mv.cursor:
+			lines[i] = AppStyles.TableSelected.Render(line)
+		case mp.Type == mount.TypeVolume:
+			lines[i] = AppStyles.TableHeader.Render(line)
+		default:
+			lines[i] = line
+		}
+	}
+	mv.viewport.SetContent(strings.Join(lines, "\n"))
+
+	// Scroll just enough to keep the cursor on screen
+	if mv.cursor < mv.viewport.YOffset {
+		mv.viewport.SetYOffset(mv.cursor)
+	} else if mv.cursor >= mv.viewport.YOffset+mv.viewport.Height {
+		mv.viewport.SetYOffset(mv.cursor - mv.viewport.Height + 1)
+	}
+}
+
+// Render renders the title bar, the column titles and the visible mounts
+func (mv *MountsView) Render() string {
+	status := fmt.Sprintf("%d mounts", len(mv.mounts))
+	title := fmt.Sprintf("%s %s", StyleSubtitle("Mounts: "+mv.name), StyleMuted("("+status+")"))
+	header := AppStyles.TableHeader.Render(formatProcessRow(mountTitles, mv.columnWidths()))
+	return title + "\n" + header + "\n" + mv.viewport.View()
+}
+
+// showMounts opens the mounts view for the selected container
+func (m *Model) showMounts() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	m.mountsView = NewMountsView(cont.ID, strings.TrimPrefix(cont.Names[0], "/"), cont.Mounts)
+	m.mountsView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = MountsViewMode
+}
+
+// showMountVolume switches to the volumes view with the volume under the cursor selected
+func (m *Model) showMountVolume() {
+	mp := m.mountsView.Selected()
+	if mp == nil {
+		return
+	}
+	if mp.Type != mount.TypeVolume || mp.Name == "" {
+		m.status = fmt.Sprintf("%s is a %s mount of %s, not a named volume", mp.Destination, mp.Type, mp.Source)
+		return
+	}
+
+	m.closeMounts()
+	m.currentView = VolumesView
+	if !m.volumeTable.SelectVolume(mp.Name) {
+		m.err = fmt.Errorf("volume %s is no longer listed", mp.Name)
+	}
+}
+
+// closeMounts leaves the mounts view
+func (m *Model) closeMounts() {
+	m.mountsView = nil
+	if m.currentView == MountsViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleMountsKey handles key presses while the mounts view is active
+func (m *Model) handleMountsKey(msg tea.KeyMsg) tea.Cmd {
+	mv := m.mountsView
+
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeMounts()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc", "q":
+		m.closeMounts()
+	case "up", "k":
+		mv.MoveCursor(-1)
+	case "down", "j":
+		mv.MoveCursor(1)
+	case "home", "g":
+		mv.MoveCursor(-len(mv.mounts))
+	case "end", "G":
+		mv.MoveCursor(len(mv.mounts))
+	case "enter":
+		m.showMountVolume()
+	}
+	return nil
+}
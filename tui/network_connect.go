@@ -0,0 +1,234 @@
+package tui
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+)
+
+// containerNetworks returns the names of the networks a container is attached to
+func containerNetworks(cont container.Summary) map[string]bool {
+	names := map[string]bool{}
+	if cont.NetworkSettings != nil {
+		for name := range cont.NetworkSettings.Networks {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// attachableAtRuntime reports whether a running container can be connected to
+// the network: host and none only apply at creation, and swarm networks must
+// have been created as attachable
+func attachableAtRuntime(nw network.Summary) bool {
+	switch {
+	case nw.Name == network.NetworkHost || nw.Name == network.NetworkNone:
+		return false
+	case nw.Driver == "host" || nw.Driver == "null":
+		return false
+	case nw.Ingress || (nw.Scope == "swarm" && !nw.Attachable):
+		return false
+	}
+	return true
+}
+
+// showConnectPicker asks which network to attach the selected container to
+func (m *Model) showConnectPicker() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	container := *cont
+	name := strings.TrimPrefix(container.Names[0], "/")
+	attached := containerNetworks(container)
+	var options []string
+	for _, nw := range m.networks {
+		if !attached[nw.Name] && attachableAtRuntime(nw) {
+			options = append(options, nw.Name)
+		}
+	}
+	if len(options) == 0 {
+		m.status = fmt.Sprintf("Container %s is attached to every network it can join", name)
+		return
+	}
+	sort.Strings(options)
+
+	m.showPicker(fmt.Sprintf("Connect '%s' to which network?", name), options, func(networkName string) tea.Cmd {
+		m.showConnectForm(container, networkName)
+		return nil
+	})
+}
+
+// showDisconnectPicker asks which network to detach the selected container from
+func (m *Model) showDisconnectPicker() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	container := *cont
+	name := strings.TrimPrefix(container.Names[0], "/")
+	var options []string
+	for networkName := range containerNetworks(container) {
+		options = append(options, networkName)
+	}
+	if len(options) == 0 {
+		m.status = fmt.Sprintf("Container %s is not attached to any network", name)
+		return
+	}
+	sort.Strings(options)
+
+	m.showPicker(fmt.Sprintf("Disconnect '%s' from which network?", name), options, func(networkName string) tea.Cmd {
+		m.showDisconnectConfirmation(container, networkName)
+		return nil
+	})
+}
+
+// showNetworkConnectPicker asks which container to attach to the selected network
+func (m *Model) showNetworkConnectPicker() {
+	nw := m.networkTable.GetSelectedNetwork()
+	if nw == nil {
+		return
+	}
+
+	networkName := nw.Name
+	if !attachableAtRuntime(*nw) {
+		m.status = fmt.Sprintf("Network %s can only be chosen when a container is created", networkName)
+		return
+	}
+	options, byName := m.containerChoices(func(attached map[string]bool) bool {
+		return !attached[networkName]
+	})
+	if len(options) == 0 {
+		m.status = fmt.Sprintf("Every container is attached to network %s", networkName)
+		return
+	}
+
+	m.showPicker(fmt.Sprintf("Connect which container to '%s'?", networkName), options, func(choice string) tea.Cmd {
+		m.showConnectForm(byName[choice], networkName)
+		return nil
+	})
+}
+
+// showNetworkDisconnectPicker asks which container to detach from the selected network
+func (m *Model) showNetworkDisconnectPicker() {
+	nw := m.networkTable.GetSelectedNetwork()
+	if nw == nil {
+		return
+	}
+
+	networkName := nw.Name
+	options, byName := m.containerChoices(func(attached map[string]bool) bool {
+		return attached[networkName]
+	})
+	if len(options) == 0 {
+		m.status = fmt.Sprintf("No container is attached to network %s", networkName)
+		return
+	}
+
+	m.showPicker(fmt.Sprintf("Disconnect which container from '%s'?", networkName), options, func(choice string) tea.Cmd {
+		m.showDisconnectConfirmation(byName[choice], networkName)
+		return nil
+	})
+}
+
+// containerChoices lists the names of the containers whose attached networks
+// match, sorted, with the containers they stand for
+func (m *Model) containerChoices(match func(attached map[string]bool) bool) ([]string, map[string]container.Summary) {
+	var options []string
+	byName := map[string]container.Summary{}
+	for _, cont := range m.containers {
+		if !match(containerNetworks(cont)) {
+			continue
+		}
+		name := strings.TrimPrefix(cont.Names[0], "/")
+		options = append(options, name)
+		byName[name] = cont
+	}
+	sort.Strings(options)
+	return options, byName
+}
+
+// showConnectForm asks for the optional aliases and static IP of the new endpoint
+func (m *Model) showConnectForm(cont container.Summary, networkName string) {
+	name := strings.TrimPrefix(cont.Names[0], "/")
+	form := NewFormDialog(fmt.Sprintf("Connect '%s' to network '%s'", name, networkName)).
+		AddInput("aliases", "Aliases", "db; primary", "").
+		AddInput("ip", "Static IP address", "172.20.0.10 or fd00::10", "").
+		SetHint("aliases", "Optional, separated by ';'").
+		SetHint("ip", "Optional, only supported on user-defined networks")
+
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		settings, err := endpointSettings(form, networkName)
+		if err != nil {
+			return nil, err
+		}
+		return m.connectNetwork(cont, networkName, settings), nil
+	})
+}
+
+// endpointSettings validates the connect form
+func endpointSettings(form *FormDialog, networkName string) (*network.EndpointSettings, error) {
+	settings := &network.EndpointSettings{Aliases: splitList(form.Value("aliases"))}
+	for _, alias := range settings.Aliases {
+		if strings.ContainsAny(alias, " \t/:") {
+			return nil, fmt.Errorf("invalid alias %q", alias)
+		}
+	}
+
+	if value := form.Value("ip"); value != "" {
+		switch networkName {
+		case network.NetworkDefault, network.NetworkBridge, network.NetworkHost, network.NetworkNone:
+			return nil, fmt.Errorf("static IP addresses are only supported on user-defined networks")
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q", value)
+		}
+		settings.IPAMConfig = &network.EndpointIPAMConfig{}
+		if addr.Is4() {
+			settings.IPAMConfig.IPv4Address = addr.String()
+		} else {
+			settings.IPAMConfig.IPv6Address = addr.String()
+		}
+	}
+	return settings, nil
+}
+
+func (m *Model) connectNetwork(cont container.Summary, networkName string, settings *network.EndpointSettings) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.NetworkConnect(m.ctx, networkName, cont.ID, settings)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s connected to network %s", cont.ID[:12], networkName))
+	}
+}
+
+// showDisconnectConfirmation confirms before detaching a container from a network
+func (m *Model) showDisconnectConfirmation(cont container.Summary, networkName string) {
+	message := fmt.Sprintf("Are you sure you want to disconnect container '%s' from network '%s'?",
+		strings.TrimPrefix(cont.Names[0], "/"), networkName)
+	m.confirmDialog = NewConfirmationDialog(message)
+	m.confirmDialog.SetSize(m.width, m.height)
+	m.confirmDialog.Show()
+	m.pendingAction = func() tea.Cmd {
+		return m.disconnectNetwork(cont, networkName)
+	}
+}
+
+func (m *Model) disconnectNetwork(cont container.Summary, networkName string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.dockerClient.NetworkDisconnect(m.ctx, networkName, cont.ID, false)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return statusMsg(fmt.Sprintf("Container %s disconnected from network %s", cont.ID[:12], networkName))
+	}
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// OutputView displays read-only text, such as command output, in a scrollable pane
+type OutputView struct {
+	viewport viewport.Model
+	title    string
+	status   string
+	width    int
+	height   int
+}
+
+// NewOutputView creates a new output view with the given title
+func NewOutputView(title string) *OutputView {
+	return &OutputView{
+		viewport: viewport.New(0, 0),
+		title:    title,
+	}
+}
+
+// SetSize sets the output view dimensions
+func (ov *OutputView) SetSize(width, height int) {
+	ov.width = width
+	ov.height = height
+
+	viewportHeight := height - 1 // Reserve a line for the title bar
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	ov.viewport.Width = width
+	ov.viewport.Height = viewportHeight
+}
+
+// SetContent replaces the displayed text and scrolls back to the top
+func (ov *OutputView) SetContent(content string) {
+	ov.viewport.SetContent(content)
+	ov.viewport.GotoTop()
+}
+
+// SetLiveContent replaces the displayed text of output that is still growing,
+// following its end while the view is scrolled to the bottom
+func (ov *OutputView) SetLiveContent(content string) {
+	atBottom := ov.viewport.AtBottom()
+	ov.viewport.SetContent(content)
+	if atBottom {
+		ov.viewport.GotoBottom()
+	}
+}
+
+// SetStatus sets the text shown next to the title
+func (ov *OutputView) SetStatus(status string) {
+	ov.status = status
+}
+
+// Update forwards scrolling keys to the viewport
+func (ov *OutputView) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	ov.viewport, cmd = ov.viewport.Update(msg)
+	return cmd
+}
+
+// Render renders the title bar and the visible part of the output
+func (ov *OutputView) Render() string {
+	title := StyleSubtitle(ov.title)
+	if ov.status != "" {
+		title = fmt.Sprintf("%s %s", title, StyleMuted("("+ov.status+")"))
+	}
+	return title + "\n" + ov.viewport.View()
+}
+
+// showOutput opens an output pane on top of the current view
+func (m *Model) showOutput(title string) *OutputView {
+	m.closeOutput()
+	m.outputView = NewOutputView(title)
+	m.outputView.SetSize(m.width, m.contentHeight())
+	if m.currentView != OutputViewMode {
+		m.previousView = m.currentView
+	}
+	m.currentView = OutputViewMode
+	return m.outputView
+}
+
+// closeOutput closes the output pane and cancels any work still feeding it
+func (m *Model) closeOutput() {
+	if m.outputCancel != nil {
+		m.outputCancel()
+		m.outputCancel = nil
+	}
+	m.outputView = nil
+	if m.currentView == OutputViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleOutputKey handles key presses while the output pane is active
+func (m *Model) handleOutputKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeOutput()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc", "q":
+		m.closeOutput()
+		return nil
+	case "G", "end":
+		m.outputView.viewport.GotoBottom()
+		return nil
+	case "home":
+		m.outputView.viewport.GotoTop()
+		return nil
+	}
+	return m.outputView.Update(msg)
+}
@@ -0,0 +1,410 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
+)
+
+// recreateInspectedMsg carries the configuration of the container to recreate
+type recreateInspectedMsg struct {
+	info  container.InspectResponse
+	image *container.Config // Defaults of the container's image, nil when the image is gone
+}
+
+// showRecreate loads the configuration of the selected container to edit it
+func (m *Model) showRecreate() tea.Cmd {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return nil
+	}
+
+	id := cont.ID
+	m.status = fmt.Sprintf("Loading configuration of %s...", id[:12])
+	return func() tea.Msg {
+		info, err := m.dockerClient.ContainerInspect(m.ctx, id)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		// Tells settings inherited from the image apart from the container's own
+		var image *container.Config
+		if img, err := m.dockerClient.ImageInspect(m.ctx, info.Image); err == nil && img.Config != nil {
+			image = &container.Config{
+				User:       img.Config.User,
+				Env:        img.Config.Env,
+				Entrypoint: img.Config.Entrypoint,
+				Cmd:        img.Config.Cmd,
+				Volumes:    img.Config.Volumes,
+				WorkingDir: img.Config.WorkingDir,
+				Labels:     img.Config.Labels,
+			}
+		}
+		return recreateInspectedMsg{info: info, image: image}
+	}
+}
+
+// handleRecreateInspected opens the run wizard prefilled with the container's configuration
+func (m *Model) handleRecreateInspected(msg recreateInspectedMsg) {
+	info := msg.info
+	name := strings.TrimPrefix(info.Name, "/")
+	if info.HostConfig.AutoRemove {
+		m.err = fmt.Errorf("container %s is removed when it stops and cannot be recreated", name)
+		return
+	}
+
+	env := strings.Join(info.Config.Env, "\n")
+	form := m.newRunForm(fmt.Sprintf("Recreate container '%s'", name), info.ID).
+		SetValue("image", info.Config.Image).
+		SetValue("name", name).
+		SetValue("command", joinArgs(info.Config.Cmd)).
+		SetValue("env", env).
+		SetValue("ports", formatPortBindings(info.HostConfig.PortBindings)).
+		SetValue("mounts", formatMountPoints(info.Mounts)).
+		SetValue("network", networkModeName(info.HostConfig.NetworkMode)).
+		SetValue("restart", formatRestartPolicy(info.HostConfig.RestartPolicy)).
+		SetValue("memory", formatMemoryLimit(info.HostConfig.Memory)).
+		SetValue("cpus", formatCPULimit(info.HostConfig.Resources)).
+		AddToggle("removeold", "Remove the old container once the new one runs", false)
+
+	m.status = ""
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		req, err := m.createRequest(form, info.ID)
+		if err != nil {
+			return nil, err
+		}
+		if req.name == "" {
+			req.name = name
+		}
+		// An untouched environment is passed on as is, even values with line breaks
+		if form.Value("env") == strings.TrimSpace(env) {
+			req.config.Env = info.Config.Env
+		}
+		var image *container.Config
+		if form.Value("image") != info.Config.Image {
+			image = msg.image
+		}
+		mergeRecreateConfig(&req, info, image)
+
+		recreate := m.recreateContainer(info, req, form.Checked("removeold"))
+		if req.pull {
+			m.status = fmt.Sprintf("Pulling %s...", req.config.Image)
+			return m.pullImage(req.config.Image, recreate), nil
+		}
+		m.status = fmt.Sprintf("Recreating container %s...", name)
+		return recreate, nil
+	})
+}
+
+// mergeRecreateConfig keeps the settings of the old container that the
+// wizard doesn't edit, such as labels, entrypoint, user and capabilities.
+// oldImage is set when the image changes: settings the container only
+// inherited from it are dropped so the new image's defaults apply.
+func mergeRecreateConfig(req *createRequest, info container.InspectResponse, oldImage *container.Config) {
+	config := *info.Config
+	config.Image = req.config.Image
+	config.Cmd = req.config.Cmd
+	config.Env = req.config.Env
+	if oldImage != nil {
+		dropImageDefaults(&config, oldImage)
+	}
+	config.ExposedPorts = nat.PortSet{}
+	maps.Copy(config.ExposedPorts, info.Config.ExposedPorts)
+	maps.Copy(config.ExposedPorts, req.config.ExposedPorts)
+	// A hostname defaulted from the old container ID would be misleading
+	if strings.HasPrefix(info.ID, config.Hostname) {
+		config.Hostname = ""
+	}
+	req.config = &config
+
+	hostConfig := *info.HostConfig
+	hostConfig.PortBindings = req.hostConfig.PortBindings
+	hostConfig.Binds = nil // The mounts field lists every volume and bind mount
+	hostConfig.Mounts = req.hostConfig.Mounts
+	for _, mnt := range info.HostConfig.Mounts {
+		if mnt.Type != mount.TypeVolume && mnt.Type != mount.TypeBind {
+			hostConfig.Mounts = append(hostConfig.Mounts, mnt)
+		}
+	}
+	hostConfig.RestartPolicy = req.hostConfig.RestartPolicy
+	hostConfig.AutoRemove = req.hostConfig.AutoRemove
+	hostConfig.Memory = req.hostConfig.Memory
+	if hostConfig.Memory != info.HostConfig.Memory {
+		hostConfig.MemorySwap = scaleMemorySwap(info.HostConfig.Memory, info.HostConfig.MemorySwap, hostConfig.Memory)
+	}
+	hostConfig.NanoCPUs = req.hostConfig.NanoCPUs
+	hostConfig.CPUPeriod, hostConfig.CPUQuota = 0, 0 // Replaced by NanoCPUs
+	if req.hostConfig.NetworkMode != "" {
+		hostConfig.NetworkMode = req.hostConfig.NetworkMode
+	}
+	req.hostConfig = &hostConfig
+
+	// Keep the aliases and static addresses of every network that stays
+	oldPrimary := info.HostConfig.NetworkMode
+	if oldPrimary.IsDefault() {
+		oldPrimary = network.NetworkBridge
+	}
+	endpoints := map[string]*network.EndpointSettings{}
+	if info.NetworkSettings != nil {
+		for name, settings := range info.NetworkSettings.Networks {
+			if name == string(oldPrimary) && oldPrimary != hostConfig.NetworkMode && !hostConfig.NetworkMode.IsDefault() {
+				continue
+			}
+			endpoints[name] = &network.EndpointSettings{
+				IPAMConfig: settings.IPAMConfig,
+				Links:      settings.Links,
+				Aliases:    settings.Aliases,
+				DriverOpts: settings.DriverOpts,
+			}
+		}
+	}
+	if req.network != nil {
+		for name := range req.network.EndpointsConfig {
+			if _, ok := endpoints[name]; !ok {
+				endpoints[name] = &network.EndpointSettings{}
+			}
+		}
+	}
+	// Containers sharing another container's or the host's stack have no endpoints
+	if hostConfig.NetworkMode.IsContainer() || hostConfig.NetworkMode.IsHost() || hostConfig.NetworkMode.IsNone() {
+		endpoints = nil
+	}
+	req.network = &network.NetworkingConfig{EndpointsConfig: endpoints}
+}
+
+// dropImageDefaults clears the settings of config that are the same as in
+// the image it was created from
+func dropImageDefaults(config, image *container.Config) {
+	if slices.Equal(config.Entrypoint, image.Entrypoint) {
+		config.Entrypoint = nil
+	}
+	if slices.Equal(config.Cmd, image.Cmd) {
+		config.Cmd = nil
+	}
+	if config.WorkingDir == image.WorkingDir {
+		config.WorkingDir = ""
+	}
+	if config.User == image.User {
+		config.User = ""
+	}
+	config.Env = slices.DeleteFunc(slices.Clone(config.Env), func(kv string) bool {
+		return slices.Contains(image.Env, kv)
+	})
+
+	labels := make(map[string]string, len(config.Labels))
+	for key, value := range config.Labels {
+		if inherited, exists := image.Labels[key]; !exists || inherited != value {
+			labels[key] = value
+		}
+	}
+	config.Labels = labels
+
+	volumes := make(map[string]struct{}, len(config.Volumes))
+	for path := range config.Volumes {
+		if _, exists := image.Volumes[path]; !exists {
+			volumes[path] = struct{}{}
+		}
+	}
+	config.Volumes = volumes
+}
+
+// scaleMemorySwap returns the swap limit for a new memory limit, keeping the
+// ratio to the old limit. The daemon stores twice the memory limit when none
+// was given, and refuses a swap limit below the memory limit or without one.
+func scaleMemorySwap(oldMemory, oldSwap, memory int64) int64 {
+	switch {
+	case memory == 0:
+		return 0
+	case oldSwap < 0:
+		return oldSwap // Unlimited swap
+	case oldMemory == 0 || oldSwap == 0:
+		return 0
+	}
+	return int64(float64(oldSwap) / float64(oldMemory) * float64(memory))
+}
+
+// recreateContainer replaces a container with one created from req: the old
+// container is stopped and, when the name is reused, renamed out of the way.
+// If the new container fails to start it is removed and the old one restored.
+func (m *Model) recreateContainer(old container.InspectResponse, req createRequest, removeOld bool) tea.Cmd {
+	return func() tea.Msg {
+		oldName := strings.TrimPrefix(old.Name, "/")
+		wasRunning := old.State != nil && old.State.Running
+
+		if wasRunning {
+			if err := m.dockerClient.ContainerStop(m.ctx, old.ID, container.StopOptions{}); err != nil {
+				return errorMsg{fmt.Errorf("error stopping %s: %w", oldName, err)}
+			}
+		}
+
+		// restore puts the old container back as it was
+		renamed := false
+		restore := func(cause error) tea.Msg {
+			var errs []error
+			if renamed {
+				if err := m.dockerClient.ContainerRename(m.ctx, old.ID, oldName); err != nil {
+					errs = append(errs, fmt.Errorf("renaming back: %w", err))
+				}
+			}
+			if wasRunning {
+				if err := m.dockerClient.ContainerStart(m.ctx, old.ID, container.StartOptions{}); err != nil {
+					errs = append(errs, fmt.Errorf("restarting: %w", err))
+				}
+			}
+			if len(errs) > 0 {
+				return errorMsg{fmt.Errorf("%w; restoring %s failed: %w", cause, oldName, errors.Join(errs...))}
+			}
+			return errorMsg{fmt.Errorf("%w; %s was restored", cause, oldName)}
+		}
+
+		if req.name == oldName {
+			backup := fmt.Sprintf("%s-old-%d", oldName, time.Now().Unix())
+			if err := m.dockerClient.ContainerRename(m.ctx, old.ID, backup); err != nil {
+				return restore(fmt.Errorf("error renaming %s: %w", oldName, err))
+			}
+			renamed = true
+		}
+
+		resp, err := m.dockerClient.ContainerCreate(m.ctx, req.config, req.hostConfig, req.network, nil, req.name)
+		if err != nil {
+			return restore(fmt.Errorf("error creating container: %w", err))
+		}
+
+		if req.start {
+			if err := m.dockerClient.ContainerStart(m.ctx, resp.ID, container.StartOptions{}); err != nil {
+				cause := fmt.Errorf("new container failed to start: %w", err)
+				if rmErr := m.dockerClient.ContainerRemove(m.ctx, resp.ID, container.RemoveOptions{Force: true}); rmErr != nil {
+					cause = fmt.Errorf("%w; removing it failed: %w", cause, rmErr)
+				}
+				return restore(cause)
+			}
+		}
+
+		status := fmt.Sprintf("Recreated container %s", req.name)
+		if removeOld {
+			if err := m.dockerClient.ContainerRemove(m.ctx, old.ID, container.RemoveOptions{}); err != nil {
+				return errorMsg{fmt.Errorf("recreated %s but removing the old container failed: %w", req.name, err)}
+			}
+		} else {
+			status += fmt.Sprintf(", old container kept as %s", old.ID[:12])
+		}
+		return containerCreatedMsg{id: resp.ID, status: status}
+	}
+}
+
+// networkModeName returns the network to show in the wizard; the default
+// mode and modes sharing another container's stack are left empty to keep them
+func networkModeName(mode container.NetworkMode) string {
+	if mode.IsDefault() || mode.IsContainer() {
+		return ""
+	}
+	return string(mode)
+}
+
+// joinArgs quotes arguments so that splitting them again gives the same list
+func joinArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		switch {
+		case arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\#"):
+			quoted[i] = arg
+		case !strings.Contains(arg, "'"):
+			quoted[i] = "'" + arg + "'"
+		default:
+			quoted[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// formatPortBindings formats bindings in the format parsePortMappings reads
+func formatPortBindings(bindings nat.PortMap) string {
+	var specs []string
+	for port, hostBindings := range bindings {
+		for _, binding := range hostBindings {
+			spec := port.Port() + "/" + port.Proto()
+			if binding.HostPort != "" {
+				spec = binding.HostPort + ":" + spec
+			}
+			if binding.HostIP != "" {
+				spec = binding.HostIP + ":" + spec
+			}
+			specs = append(specs, spec)
+		}
+	}
+	sort.Strings(specs)
+	return strings.Join(specs, "; ")
+}
+
+// formatMountPoints formats mounts in the format parseMounts reads
+func formatMountPoints(mounts []container.MountPoint) string {
+	var specs []string
+	for _, mp := range mounts {
+		var spec string
+		switch mp.Type {
+		case mount.TypeVolume:
+			spec = mp.Name + ":" + mp.Destination
+		case mount.TypeBind:
+			spec = mp.Source + ":" + mp.Destination
+		default:
+			continue // tmpfs and other mounts are kept by the host config
+		}
+		if !mp.RW {
+			spec += ":ro"
+		}
+		specs = append(specs, spec)
+	}
+	return strings.Join(specs, "; ")
+}
+
+func formatRestartPolicy(policy container.RestartPolicy) string {
+	if policy.Name == "" {
+		return string(container.RestartPolicyDisabled)
+	}
+	if policy.MaximumRetryCount > 0 {
+		return fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+	}
+	return string(policy.Name)
+}
+
+func formatMemoryLimit(memory int64) string {
+	if memory == 0 {
+		return ""
+	}
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"g", units.GiB}, {"m", units.MiB}, {"k", units.KiB}} {
+		if memory%unit.size == 0 {
+			return strconv.FormatInt(memory/unit.size, 10) + unit.suffix
+		}
+	}
+	return strconv.FormatInt(memory, 10)
+}
+
+// formatCPULimit returns the CPU count set with --cpus or derived from a CFS quota
+func formatCPULimit(resources container.Resources) string {
+	switch {
+	case resources.NanoCPUs > 0:
+		return strconv.FormatFloat(float64(resources.NanoCPUs)/1e9, 'f', -1, 64)
+	case resources.CPUQuota > 0:
+		period := resources.CPUPeriod
+		if period == 0 {
+			period = 100000 // Kernel default
+		}
+		return strconv.FormatFloat(float64(resources.CPUQuota)/float64(period), 'f', -1, 64)
+	}
+	return ""
+}
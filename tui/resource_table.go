@@ -0,0 +1,306 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Usage above these levels is flagged in the resources dashboard
+const (
+	cpuWarnPercent = 50.0
+	cpuCritPercent = 80.0
+	memWarnPercent = 75.0
+	memCritPercent = 90.0
+)
+
+// Spans the dashboard averages over, cycled with +/-
+var resourceSpans = []time.Duration{
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+}
+
+// resourceMetric is the column the dashboard is ranked by
+type resourceMetric int
+
+const (
+	rankByCPU resourceMetric = iota
+	rankByMemory
+	rankByNetwork
+	rankByBlockIO
+)
+
+var resourceMetricNames = map[resourceMetric]string{
+	rankByCPU:     "cpu",
+	rankByMemory:  "memory",
+	rankByNetwork: "network",
+	rankByBlockIO: "block io",
+}
+
+// resourceEntry is the averaged usage of one container over the dashboard span
+type resourceEntry struct {
+	id         string
+	name       string
+	cpuPercent float64
+	memUsage   float64
+	memPercent float64
+	netRx      float64
+	netTx      float64
+	blockRead  float64
+	blockWrite float64
+}
+
+// value returns the figure the entry is ranked by
+func (e resourceEntry) value(metric resourceMetric) float64 {
+	switch metric {
+	case rankByMemory:
+		return e.memUsage
+	case rankByNetwork:
+		return e.netRx + e.netTx
+	case rankByBlockIO:
+		return e.blockRead + e.blockWrite
+	default:
+		return e.cpuPercent
+	}
+}
+
+// level returns "critical", "warning" or "" depending on the thresholds crossed
+func (e resourceEntry) level() string {
+	switch {
+	case e.cpuPercent >= cpuCritPercent || e.memPercent >= memCritPercent:
+		return "critical"
+	case e.cpuPercent >= cpuWarnPercent || e.memPercent >= memWarnPercent:
+		return "warning"
+	}
+	return ""
+}
+
+// ResourceTable ranks running containers by their recent resource usage
+type ResourceTable struct {
+	table   table.Model
+	model   *Model
+	entries []resourceEntry
+	metric  resourceMetric
+	span    int // Index into resourceSpans
+}
+
+// NewResourceTable creates a new resource table
+func NewResourceTable(m *Model) *ResourceTable {
+	resourceColumns := []table.Column{
+		{Title: "Name", Width: 30},
+		{Title: "Level", Width: 8},
+		{Title: "CPU %", Width: 10},
+		{Title: "Memory", Width: 12},
+		{Title: "Mem %", Width: 8},
+		{Title: "Net RX / TX", Width: 22},
+		{Title: "Block R / W", Width: 22},
+	}
+
+	resourceTable := table.New(
+		table.WithColumns(resourceColumns),
+		table.WithFocused(true),
+		table.WithHeight(10),
+	)
+
+	rt := &ResourceTable{
+		table: resourceTable,
+		model: m,
+		span:  1,
+	}
+
+	rt.applyStyles()
+	return rt
+}
+
+// GetTable returns the underlying table model
+func (rt *ResourceTable) GetTable() table.Model {
+	return rt.table
+}
+
+// SetTable updates the underlying table model
+func (rt *ResourceTable) SetTable(t table.Model) {
+	rt.table = t
+}
+
+// applyStyles applies the current theme styles to the table
+func (rt *ResourceTable) applyStyles() {
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color(Colors.BorderNormal)).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(lipgloss.Color(Colors.TextHighlight))
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color(Colors.TextSecondary)).
+		Background(lipgloss.Color(Colors.Primary)).
+		Bold(true)
+
+	rt.table.SetStyles(s)
+}
+
+// RefreshStyles reapplies the current styles (useful after theme changes)
+func (rt *ResourceTable) RefreshStyles() {
+	rt.applyStyles()
+}
+
+// SetHeight sets the table height
+func (rt *ResourceTable) SetHeight(height int) {
+	rt.table.SetHeight(height)
+}
+
+// SetColumns updates the table columns
+func (rt *ResourceTable) SetColumns(columns []table.Column) {
+	rt.table.SetColumns(columns)
+}
+
+// Span returns how far back usage is averaged
+func (rt *ResourceTable) Span() time.Duration {
+	return resourceSpans[rt.span]
+}
+
+// CycleMetric ranks by the next metric
+func (rt *ResourceTable) CycleMetric() {
+	rt.metric = (rt.metric + 1) % resourceMetric(len(resourceMetricNames))
+	rt.Update()
+}
+
+// ChangeSpan averages over a longer (delta > 0) or shorter span
+func (rt *ResourceTable) ChangeSpan(delta int) {
+	rt.span = max(0, min(len(resourceSpans)-1, rt.span+delta))
+	rt.Update()
+}
+
+// Update ranks the running containers from their stats history, keeping the
+// cursor on the selected container as rows move
+func (rt *ResourceTable) Update() {
+	selectedID := ""
+	if selected := rt.GetSelectedEntry(); selected != nil {
+		selectedID = selected.id
+	}
+
+	rt.entries = rt.entries[:0]
+	for _, cont := range rt.model.containers {
+		if cont.State != "running" {
+			continue
+		}
+		if entry, ok := rt.model.averageUsage(cont.ID, rt.Span()); ok {
+			entry.name = strings.TrimPrefix(cont.Names[0], "/")
+			rt.entries = append(rt.entries, entry)
+		}
+	}
+	sort.SliceStable(rt.entries, func(i, j int) bool {
+		return rt.entries[i].value(rt.metric) > rt.entries[j].value(rt.metric)
+	})
+
+	rows := make([]table.Row, len(rt.entries))
+	cursor := rt.table.Cursor()
+	for i, entry := range rt.entries {
+		memPercent := "-"
+		if entry.memPercent > 0 {
+			memPercent = formatPercent(entry.memPercent)
+		}
+		rows[i] = table.Row{
+			entry.name,
+			entry.level(),
+			formatPercent(entry.cpuPercent),
+			formatBytes(entry.memUsage),
+			memPercent,
+			fmt.Sprintf("%s / %s", formatRate(entry.netRx), formatRate(entry.netTx)),
+			fmt.Sprintf("%s / %s", formatRate(entry.blockRead), formatRate(entry.blockWrite)),
+		}
+		if entry.id == selectedID {
+			cursor = i
+		}
+	}
+	rt.table.SetRows(rows)
+	if len(rows) > 0 {
+		rt.table.SetCursor(min(cursor, len(rows)-1))
+	}
+}
+
+// averageUsage averages a container's stats history over the last span,
+// measured back from its newest sample
+func (m *Model) averageUsage(containerID string, span time.Duration) (resourceEntry, bool) {
+	history, exists := m.statsHistory[containerID]
+	if !exists || len(history.points) == 0 {
+		return resourceEntry{}, false
+	}
+
+	entry := resourceEntry{id: containerID}
+	cutoff := history.points[len(history.points)-1].t.Add(-span)
+	count := 0
+	for i := len(history.points) - 1; i >= 0 && !history.points[i].t.Before(cutoff); i-- {
+		point := history.points[i]
+		entry.cpuPercent += point.cpuPercent
+		entry.memUsage += point.memUsage
+		entry.netRx += point.netRx
+		entry.netTx += point.netTx
+		entry.blockRead += point.blockRead
+		entry.blockWrite += point.blockWrite
+		count++
+	}
+
+	n := float64(count)
+	entry.cpuPercent /= n
+	entry.memUsage /= n
+	entry.netRx /= n
+	entry.netTx /= n
+	entry.blockRead /= n
+	entry.blockWrite /= n
+	if history.last.memLimit > 0 {
+		entry.memPercent = entry.memUsage / float64(history.last.memLimit) * 100
+	}
+	return entry, true
+}
+
+// GetSelectedEntry returns the currently selected entry, if any
+func (rt *ResourceTable) GetSelectedEntry() *resourceEntry {
+	cursor := rt.table.Cursor()
+	if cursor >= 0 && cursor < len(rt.entries) {
+		return &rt.entries[cursor]
+	}
+	return nil
+}
+
+// View returns the rendered table; rows over a threshold name it in the Level column
+func (rt *ResourceTable) View() string {
+	title := StyleMuted(fmt.Sprintf("Ranked by %s, averaged over the last %s (CPU ≥ %.0f%%/%.0f%%, memory ≥ %.0f%%/%.0f%% of limit flagged as warning/critical)",
+		resourceMetricNames[rt.metric], rt.Span(), cpuWarnPercent, cpuCritPercent, memWarnPercent, memCritPercent))
+
+	return title + "\n" + rt.table.View()
+}
+
+// truncateCell shortens text the way the table does for a cell of the given width
+func truncateCell(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width || width < 1 {
+		return text
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// Cursor returns the current cursor position
+func (rt *ResourceTable) Cursor() int {
+	return rt.table.Cursor()
+}
+
+// showResourceContainer switches to the containers view with the container
+// selected in the resources dashboard under the cursor
+func (m *Model) showResourceContainer() {
+	entry := m.resourceTable.GetSelectedEntry()
+	if entry == nil {
+		return
+	}
+
+	m.currentView = ContainersView
+	if !m.containerTable.SelectContainer(entry.id) {
+		m.err = fmt.Errorf("container %s is no longer listed", entry.name)
+	}
+}
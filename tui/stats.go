@@ -0,0 +1,263 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+)
+
+// maxStatsBatch caps the number of samples delivered to Update in a single message
+const maxStatsBatch = 100
+
+// containerStats is a resource usage sample computed like `docker stats` does
+type containerStats struct {
+	read       time.Time
+	cpuPercent float64
+	memUsage   uint64
+	memLimit   uint64
+	memPercent float64
+	netRx      uint64
+	netTx      uint64
+	blockRead  uint64
+	blockWrite uint64
+}
+
+// statsStream is a running ContainerStats request
+type statsStream struct {
+	cancel context.CancelFunc
+}
+
+// statsSample is sent by a stats stream for every decoded sample and once when it ends
+type statsSample struct {
+	containerID string
+	stream      *statsStream
+	stats       containerStats
+	ended       bool
+}
+
+// statsMsg carries a batch of samples read from the stats streams
+type statsMsg []statsSample
+
+// waitForStats returns a command that blocks until the next batch of samples is available
+func (m *Model) waitForStats() tea.Cmd {
+	return func() tea.Msg {
+		batch := statsMsg{<-m.statsUpdates}
+		for len(batch) < maxStatsBatch {
+			select {
+			case sample := <-m.statsUpdates:
+				batch = append(batch, sample)
+			default:
+				return batch
+			}
+		}
+		return batch
+	}
+}
+
+// syncStatsStreams starts a stream for every running container and stops the
+// streams of containers that stopped or disappeared
+func (m *Model) syncStatsStreams() {
+	running := make(map[string]bool)
+	listed := make(map[string]bool)
+	for _, cont := range m.containers {
+		listed[cont.ID] = true
+		if cont.State == "running" {
+			running[cont.ID] = true
+		}
+	}
+
+	// History is kept for stopped containers until they are removed
+	for id := range m.statsHistory {
+		if !listed[id] {
+			delete(m.statsHistory, id)
+		}
+	}
+
+	for id, stream := range m.statsStreams {
+		if !running[id] {
+			stream.cancel()
+			delete(m.statsStreams, id)
+			delete(m.stats, id)
+		}
+	}
+
+	for id := range running {
+		if _, exists := m.statsStreams[id]; !exists {
+			m.startStatsStream(id)
+		}
+	}
+}
+
+// startStatsStream follows the stats of a container in the background
+func (m *Model) startStatsStream(containerID string) {
+	ctx, cancel := context.WithCancel(m.ctx)
+	stream := &statsStream{cancel: cancel}
+	m.statsStreams[containerID] = stream
+
+	go func() {
+		m.copyContainerStats(ctx, containerID, stream)
+
+		// Let Update forget the stream so it is restarted if the container comes back
+		select {
+		case m.statsUpdates <- statsSample{containerID: containerID, stream: stream, ended: true}:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// copyContainerStats decodes samples until the context is cancelled or the container stops
+func (m *Model) copyContainerStats(ctx context.Context, containerID string, stream *statsStream) {
+	resp, err := m.dockerClient.ContainerStats(ctx, containerID, true)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var raw container.StatsResponse
+		if err := dec.Decode(&raw); err != nil {
+			return
+		}
+
+		sample := statsSample{containerID: containerID, stream: stream, stats: calculateStats(&raw)}
+		select {
+		case m.statsUpdates <- sample:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// handleStats stores a batch of samples and waits for the next one
+func (m *Model) handleStats(msg statsMsg) tea.Cmd {
+	for _, sample := range msg {
+		// Drop samples from streams that have since been replaced or cancelled
+		if m.statsStreams[sample.containerID] != sample.stream {
+			continue
+		}
+		if sample.ended {
+			delete(m.statsStreams, sample.containerID)
+			delete(m.stats, sample.containerID)
+			continue
+		}
+		m.stats[sample.containerID] = sample.stats
+		m.recordStats(sample.containerID, sample.stats)
+	}
+
+	// Group headers show summed usage even when the stats columns are hidden
+	if (m.showStats || m.groupByCompose) && m.currentView == ContainersView {
+		m.containerTable.Update()
+	}
+	if m.currentView == ResourcesView {
+		m.resourceTable.Update()
+	}
+	return m.waitForStats()
+}
+
+// toggleStats shows or hides the resource usage columns in the container table
+func (m *Model) toggleStats() {
+	m.showStats = !m.showStats
+	m.containerTable.ResetColumns()
+	m.updateColumnWidths()
+	m.containerTable.Update()
+}
+
+// calculateStats converts a raw sample into the figures shown by `docker stats`
+func calculateStats(raw *container.StatsResponse) containerStats {
+	stats := containerStats{
+		read:     raw.Read,
+		memLimit: raw.MemoryStats.Limit,
+	}
+
+	cpuDelta := float64(raw.CPUStats.CPUUsage.TotalUsage) - float64(raw.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(raw.CPUStats.SystemUsage) - float64(raw.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(raw.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(raw.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		stats.cpuPercent = cpuDelta / systemDelta * onlineCPUs * 100
+	}
+
+	// Page cache is not counted as used memory, matching the Docker CLI
+	stats.memUsage = raw.MemoryStats.Usage
+	for _, key := range []string{"total_inactive_file", "inactive_file"} {
+		if cache, exists := raw.MemoryStats.Stats[key]; exists && cache < stats.memUsage {
+			stats.memUsage -= cache
+			break
+		}
+	}
+	if stats.memLimit > 0 {
+		stats.memPercent = float64(stats.memUsage) / float64(stats.memLimit) * 100
+	}
+
+	for _, net := range raw.Networks {
+		stats.netRx += net.RxBytes
+		stats.netTx += net.TxBytes
+	}
+
+	for _, entry := range raw.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			stats.blockRead += entry.Value
+		case "write":
+			stats.blockWrite += entry.Value
+		}
+	}
+
+	return stats
+}
+
+// statsCells returns the CPU, memory, network and block IO cells for a container
+func (m *Model) statsCells(cont container.Summary) []string {
+	if cont.State != "running" {
+		return []string{"-", "-", "-", "-"}
+	}
+	stats, exists := m.stats[cont.ID]
+	if !exists {
+		return []string{"...", "...", "...", "..."}
+	}
+	return []string{
+		fmt.Sprintf("%.2f%%", stats.cpuPercent),
+		fmt.Sprintf("%s / %s", formatSize(int64(stats.memUsage)), formatSize(int64(stats.memLimit))),
+		fmt.Sprintf("%s / %s", formatSize(int64(stats.netRx)), formatSize(int64(stats.netTx))),
+		fmt.Sprintf("%s / %s", formatSize(int64(stats.blockRead)), formatSize(int64(stats.blockWrite))),
+	}
+}
+
+// groupUsage sums the CPU and memory usage of the sampled containers of a group
+func (m *Model) groupUsage(group ContainerGroup) (cpuPercent float64, memUsage uint64, sampled bool) {
+	for _, cont := range group.Containers {
+		if stats, exists := m.stats[cont.ID]; exists {
+			cpuPercent += stats.cpuPercent
+			memUsage += stats.memUsage
+			sampled = true
+		}
+	}
+	return cpuPercent, memUsage, sampled
+}
+
+// groupStatsCells returns the stats cells for a group header: summed CPU and memory, no IO
+func (m *Model) groupStatsCells(group ContainerGroup) []string {
+	cpuPercent, memUsage, sampled := m.groupUsage(group)
+	if !sampled {
+		return []string{"", "", "", ""}
+	}
+	return []string{fmt.Sprintf("%.2f%%", cpuPercent), formatSize(int64(memUsage)), "", ""}
+}
+
+// groupUsageSummary describes the summed usage of a group for its header row
+// when the stats columns are hidden
+func (m *Model) groupUsageSummary(group ContainerGroup) string {
+	cpuPercent, memUsage, sampled := m.groupUsage(group)
+	if !sampled {
+		return ""
+	}
+	return fmt.Sprintf("CPU %.2f%%, Mem %s", cpuPercent, formatSize(int64(memUsage)))
+}
@@ -0,0 +1,384 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// DefaultStatsWindow is how much stats history is kept per container by default
+	DefaultStatsWindow = 5 * time.Minute
+	// statsStaleAfter is how long a sample is carried forward when merging
+	// series; the daemon sends one per second, so an older sample means the
+	// container stopped or its stream stalled
+	statsStaleAfter = 3 * time.Second
+)
+
+// sparkBlocks are the eighth-height blocks used to draw charts
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// statsPoint is one entry of a container's stats history; IO figures are rates in bytes per second
+type statsPoint struct {
+	t          time.Time
+	cpuPercent float64
+	memUsage   float64
+	netRx      float64
+	netTx      float64
+	blockRead  float64
+	blockWrite float64
+}
+
+// statsHistory is the rolling history of a single container
+type statsHistory struct {
+	points []statsPoint
+	last   containerStats // Previous sample, used to turn counters into rates
+}
+
+// recordStats appends a sample to the container's history and drops points
+// that fell out of the window
+func (m *Model) recordStats(containerID string, stats containerStats) {
+	history, exists := m.statsHistory[containerID]
+	if !exists {
+		history = &statsHistory{}
+		m.statsHistory[containerID] = history
+	}
+
+	point := statsPoint{
+		t:          stats.read,
+		cpuPercent: stats.cpuPercent,
+		memUsage:   float64(stats.memUsage),
+	}
+	if elapsed := stats.read.Sub(history.last.read).Seconds(); exists && elapsed > 0 {
+		point.netRx = counterRate(history.last.netRx, stats.netRx, elapsed)
+		point.netTx = counterRate(history.last.netTx, stats.netTx, elapsed)
+		point.blockRead = counterRate(history.last.blockRead, stats.blockRead, elapsed)
+		point.blockWrite = counterRate(history.last.blockWrite, stats.blockWrite, elapsed)
+	}
+	history.last = stats
+	history.points = append(history.points, point)
+
+	cutoff := stats.read.Add(-m.statsWindow)
+	drop := sort.Search(len(history.points), func(i int) bool {
+		return !history.points[i].t.Before(cutoff)
+	})
+	history.points = history.points[drop:]
+}
+
+// counterRate returns the per second increase of a counter, treating a reset as no traffic
+func counterRate(previous, current uint64, elapsed float64) float64 {
+	if current < previous {
+		return 0
+	}
+	return float64(current-previous) / elapsed
+}
+
+// statsSeries merges the history of the given containers into one series with
+// a point per second. Each container is resampled onto that grid first, using
+// its last sample at or before every second, so containers sampled at
+// different offsets sum up instead of alternating. Samples older than
+// statsStaleAfter are not carried forward, so stopped containers drop out.
+func (m *Model) statsSeries(containerIDs []string) []statsPoint {
+	var histories [][]statsPoint
+	var first, last int64
+	for _, id := range containerIDs {
+		history, exists := m.statsHistory[id]
+		if !exists || len(history.points) == 0 {
+			continue
+		}
+		start := history.points[0].t.Unix()
+		end := history.points[len(history.points)-1].t.Unix()
+		if len(histories) == 0 || start < first {
+			first = start
+		}
+		if len(histories) == 0 || end > last {
+			last = end
+		}
+		histories = append(histories, history.points)
+	}
+	if len(histories) == 0 {
+		return nil
+	}
+
+	next := make([]int, len(histories)) // Index of the first sample after the current second, per container
+	series := make([]statsPoint, 0, last-first+1)
+	for second := first; second <= last; second++ {
+		bucket := statsPoint{t: time.Unix(second, 0)}
+		for i, points := range histories {
+			for next[i] < len(points) && points[next[i]].t.Unix() <= second {
+				next[i]++
+			}
+			if next[i] == 0 {
+				continue // No sample of this container yet
+			}
+			point := points[next[i]-1]
+			if time.Duration(second-point.t.Unix())*time.Second > statsStaleAfter {
+				continue
+			}
+			bucket.cpuPercent += point.cpuPercent
+			bucket.memUsage += point.memUsage
+			bucket.netRx += point.netRx
+			bucket.netTx += point.netTx
+			bucket.blockRead += point.blockRead
+			bucket.blockWrite += point.blockWrite
+		}
+		series = append(series, bucket)
+	}
+	return series
+}
+
+// renderChart draws the last width values as a bar chart height rows tall,
+// scaled so the largest value fills the chart
+func renderChart(values []float64, width, height int) []string {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	peak := 0.0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+
+	rows := make([][]rune, height)
+	for r := range rows {
+		rows[r] = []rune(strings.Repeat(" ", width))
+	}
+
+	offset := width - len(values) // Right-align so the newest value is at the edge
+	levels := height * len(sparkBlocks)
+	for i, v := range values {
+		level := 0
+		if peak > 0 {
+			level = int(v / peak * float64(levels))
+		}
+		for r := 0; r < height; r++ {
+			cell := level - (height-1-r)*len(sparkBlocks) // Eighths filled in this row
+			switch {
+			case cell >= len(sparkBlocks):
+				rows[r][offset+i] = sparkBlocks[len(sparkBlocks)-1]
+			case cell > 0:
+				rows[r][offset+i] = sparkBlocks[cell-1]
+			}
+		}
+	}
+
+	lines := make([]string, height)
+	for r, row := range rows {
+		lines[r] = string(row)
+	}
+	return lines
+}
+
+// StatsView shows charts of the recent resource usage of one or more containers
+type StatsView struct {
+	title        string
+	containerIDs []string
+	names        map[string]string // Member names by ID, set for group panes
+	width        int
+	height       int
+}
+
+// NewStatsView creates a stats view for the given containers; the usage of
+// several containers is summed
+func NewStatsView(title string, containerIDs []string) *StatsView {
+	return &StatsView{
+		title:        title,
+		containerIDs: containerIDs,
+	}
+}
+
+// NewGroupStatsView creates a stats view charting the summed usage of a
+// compose group, followed by a breakdown per member
+func NewGroupStatsView(group ContainerGroup) *StatsView {
+	ids := make([]string, len(group.Containers))
+	names := make(map[string]string, len(group.Containers))
+	for i, cont := range group.Containers {
+		ids[i] = cont.ID
+		names[cont.ID] = strings.TrimPrefix(cont.Names[0], "/")
+	}
+
+	sv := NewStatsView(fmt.Sprintf("%s (%d containers)", group.Name, len(group.Containers)), ids)
+	sv.names = names
+	return sv
+}
+
+// SetSize sets the stats view dimensions
+func (sv *StatsView) SetSize(width, height int) {
+	sv.width = width
+	sv.height = height
+}
+
+// statsChart describes one chart of the stats view
+type statsChart struct {
+	label  string
+	color  string
+	value  func(statsPoint) float64
+	format func(float64) string
+}
+
+// Render renders a chart per metric from the given series, and for groups the
+// latest usage of every member. Only the points that fit the width are drawn,
+// and the title and the avg/max figures cover exactly those.
+func (sv *StatsView) Render(series []statsPoint, latest map[string]containerStats) string {
+	charts := []statsChart{
+		{"CPU", Colors.Accent, func(p statsPoint) float64 { return p.cpuPercent }, formatPercent},
+		{"Memory", Colors.Secondary, func(p statsPoint) float64 { return p.memUsage }, formatBytes},
+		{"Net RX", Colors.Success, func(p statsPoint) float64 { return p.netRx }, formatRate},
+		{"Net TX", Colors.Warning, func(p statsPoint) float64 { return p.netTx }, formatRate},
+	}
+
+	if sv.width > 0 && len(series) > sv.width {
+		series = series[len(series)-sv.width:]
+	}
+
+	var content strings.Builder
+	if len(series) == 0 {
+		content.WriteString(StyleSubtitle("Stats: " + sv.title))
+		content.WriteString("\n")
+		content.WriteString(StyleMuted("Waiting for samples..."))
+		return content.String()
+	}
+
+	span := series[len(series)-1].t.Sub(series[0].t) + time.Second // Points are a second apart
+	content.WriteString(fmt.Sprintf("%s %s\n",
+		StyleSubtitle("Stats: "+sv.title),
+		StyleMuted(fmt.Sprintf("(last %s, %d samples)", span, len(series)))))
+
+	var members []string
+	if sv.names != nil {
+		members = sv.renderMembers(latest)
+	}
+
+	// Title line, then a label line and a blank line around every chart
+	chartHeight := max(1, (sv.height-1-2*len(charts)-len(members))/len(charts))
+
+	for i, chart := range charts {
+		values := make([]float64, len(series))
+		peak, sum := 0.0, 0.0
+		for j, point := range series {
+			values[j] = chart.value(point)
+			peak = max(peak, values[j])
+			sum += values[j]
+		}
+
+		if i > 0 {
+			content.WriteString("\n")
+		}
+		content.WriteString(fmt.Sprintf("%s %s\n",
+			AppStyles.TableHeader.Render(chart.label),
+			StyleMuted(fmt.Sprintf("now %s  avg %s  max %s",
+				chart.format(values[len(values)-1]),
+				chart.format(sum/float64(len(values))),
+				chart.format(peak)))))
+
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color(chart.color))
+		for _, line := range renderChart(values, sv.width, chartHeight) {
+			content.WriteString(style.Render(line))
+			content.WriteString("\n")
+		}
+	}
+
+	for _, line := range members {
+		content.WriteString("\n")
+		content.WriteString(line)
+	}
+
+	return strings.TrimSuffix(content.String(), "\n")
+}
+
+// renderMembers lists the latest usage of each group member, heaviest CPU first
+func (sv *StatsView) renderMembers(latest map[string]containerStats) []string {
+	ids := append([]string(nil), sv.containerIDs...)
+	sort.SliceStable(ids, func(i, j int) bool {
+		return latest[ids[i]].cpuPercent > latest[ids[j]].cpuPercent
+	})
+
+	nameWidth := len("Container")
+	for _, name := range sv.names {
+		nameWidth = max(nameWidth, len(name))
+	}
+
+	lines := []string{
+		"",
+		AppStyles.TableHeader.Render(fmt.Sprintf("%-*s  %8s  %10s", nameWidth, "Container", "CPU", "Memory")),
+	}
+	for _, id := range ids {
+		stats, exists := latest[id]
+		if !exists {
+			lines = append(lines, StyleMuted(fmt.Sprintf("%-*s  %8s  %10s", nameWidth, sv.names[id], "-", "-")))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%-*s  %8s  %10s", nameWidth, sv.names[id],
+			formatPercent(stats.cpuPercent), formatBytes(float64(stats.memUsage))))
+	}
+	return lines
+}
+
+func formatPercent(v float64) string {
+	return fmt.Sprintf("%.1f%%", v)
+}
+
+func formatBytes(v float64) string {
+	return formatSize(int64(v))
+}
+
+func formatRate(v float64) string {
+	return formatSize(int64(v)) + "/s"
+}
+
+// showStatsHistory opens the stats charts for the selected container, or the
+// summed charts of a compose group when the cursor is on its header
+func (m *Model) showStatsHistory() {
+	if _, _, isGroupHeader := m.getSelectedItem(); isGroupHeader {
+		group := m.getSelectedGroup()
+		if group == nil {
+			return
+		}
+		m.statsView = NewGroupStatsView(*group)
+	} else {
+		cont := m.containerTable.GetSelectedContainer()
+		if cont == nil {
+			return
+		}
+		m.statsView = NewStatsView(strings.TrimPrefix(cont.Names[0], "/"), []string{cont.ID})
+	}
+
+	m.statsView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = StatsViewMode
+}
+
+// closeStatsHistory leaves the stats charts
+func (m *Model) closeStatsHistory() {
+	m.statsView = nil
+	if m.currentView == StatsViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleStatsHistoryKey handles key presses while the stats charts are shown
+func (m *Model) handleStatsHistoryKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeStatsHistory()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc", "q":
+		m.closeStatsHistory()
+	}
+	return nil
+}
+
+// SetStatsWindow sets how much stats history is kept per container
+func (m *Model) SetStatsWindow(window time.Duration) {
+	if window > 0 {
+		m.statsWindow = window
+	}
+}
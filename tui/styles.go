@@ -139,6 +139,10 @@ type Styles struct {
 	StatusBar   lipgloss.Style
 	StatusError lipgloss.Style
 	StatusInfo  lipgloss.Style
+
+	// Logs
+	LogMatch        lipgloss.Style
+	LogMatchCurrent lipgloss.Style
 }
 
 func NewStyles() *Styles {
@@ -232,6 +236,16 @@ func NewStyles() *Styles {
 
 		StatusInfo: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(Colors.Info)),
+
+		// Logs
+		LogMatch: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(Colors.Background)).
+			Background(lipgloss.Color(Colors.Warning)),
+
+		LogMatchCurrent: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(Colors.Background)).
+			Background(lipgloss.Color(Colors.Accent)).
+			Bold(true),
 	}
 }
 
@@ -272,6 +286,10 @@ func StyleError(text string) string {
 	return AppStyles.TextError.Render(text)
 }
 
+func StyleWarning(text string) string {
+	return AppStyles.TextWarning.Render(text)
+}
+
 func StyleSuccess(text string) string {
 	return AppStyles.TextSuccess.Render(text)
 }
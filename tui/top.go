@@ -0,0 +1,382 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// topArgs asks ps for the columns the process view can sort by
+var topArgs = []string{"-eo", "pid,ppid,user,pcpu,pmem,etime,args"}
+
+// topSort is the column the process list is ordered by
+type topSort int
+
+const (
+	sortByPID topSort = iota
+	sortByCPU
+	sortByCommand
+)
+
+var topSortNames = map[topSort]string{
+	sortByPID:     "pid",
+	sortByCPU:     "cpu",
+	sortByCommand: "command",
+}
+
+// Column titles each sort key maps to; the first found is used, since the
+// titles depend on the ps arguments the daemon accepted
+var topSortColumns = map[topSort][]string{
+	sortByPID:     {"PID"},
+	sortByCPU:     {"%CPU", "C"},
+	sortByCommand: {"COMMAND", "CMD", "ARGS"},
+}
+
+// topSource is a container whose processes are listed, with the service
+// name shown when listing a whole compose group
+type topSource struct {
+	containerID string
+	service     string
+}
+
+// TopView shows the processes running in one or more containers
+type TopView struct {
+	viewport  viewport.Model
+	key       string // Container ID, or "group:" and the project name
+	title     string
+	sources   []topSource
+	titles    []string
+	processes [][]string
+	sort      topSort
+	reverse   bool
+	updated   time.Time
+	err       error
+	width     int
+	height    int
+}
+
+// NewTopView creates a new, still loading, process view
+func NewTopView(key, title string, sources []topSource) *TopView {
+	tv := &TopView{
+		viewport: viewport.New(0, 0),
+		key:      key,
+		title:    title,
+		sources:  sources,
+	}
+	tv.refreshContent()
+	return tv
+}
+
+// SetSize sets the process view dimensions
+func (tv *TopView) SetSize(width, height int) {
+	tv.width = width
+	tv.height = height
+
+	viewportHeight := height - 2 // Reserve lines for the title bar and column titles
+	if viewportHeight < 1 {
+		viewportHeight = 1
+	}
+	tv.viewport.Width = width
+	tv.viewport.Height = viewportHeight
+	tv.refreshContent()
+}
+
+// SetProcesses replaces the process table
+func (tv *TopView) SetProcesses(titles []string, processes [][]string) {
+	tv.titles = titles
+	tv.processes = processes
+	tv.updated = time.Now()
+	tv.err = nil
+	tv.sortProcesses()
+	tv.refreshContent()
+}
+
+// SetError shows an error instead of the process table
+func (tv *TopView) SetError(err error) {
+	tv.err = err
+	tv.refreshContent()
+}
+
+// CycleSort orders the processes by the next column
+func (tv *TopView) CycleSort() {
+	tv.sort = (tv.sort + 1) % topSort(len(topSortNames))
+	tv.reverse = tv.sort == sortByCPU // Busiest processes first
+	tv.sortProcesses()
+	tv.refreshContent()
+}
+
+// ToggleReverse reverses the sort order
+func (tv *TopView) ToggleReverse() {
+	tv.reverse = !tv.reverse
+	tv.sortProcesses()
+	tv.refreshContent()
+}
+
+// sortColumn returns the index of the column sorted by, or -1
+func (tv *TopView) sortColumn() int {
+	for _, name := range topSortColumns[tv.sort] {
+		for i, title := range tv.titles {
+			if title == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (tv *TopView) sortProcesses() {
+	col := tv.sortColumn()
+	if col < 0 {
+		return
+	}
+
+	numeric := tv.sort != sortByCommand
+	sort.SliceStable(tv.processes, func(i, j int) bool {
+		a, b := processCell(tv.processes[i], col), processCell(tv.processes[j], col)
+		if tv.reverse {
+			a, b = b, a
+		}
+		if numeric {
+			x, _ := strconv.ParseFloat(a, 64)
+			y, _ := strconv.ParseFloat(b, 64)
+			return x < y
+		}
+		return a < b
+	})
+}
+
+func processCell(row []string, col int) string {
+	if col < len(row) {
+		return row[col]
+	}
+	return ""
+}
+
+// columnWidths sizes every column to its widest value; the last column
+// takes whatever width is left
+func (tv *TopView) columnWidths() []int {
+	widths := make([]int, len(tv.titles))
+	for i, title := range tv.titles {
+		widths[i] = len(title) + 2 // Room for the sort marker
+	}
+	for _, row := range tv.processes {
+		for i := range widths {
+			widths[i] = max(widths[i], len(processCell(row, i)))
+		}
+	}
+	if last := len(widths) - 1; last >= 0 {
+		used := 0
+		for _, w := range widths[:last] {
+			used += w + 2
+		}
+		widths[last] = max(10, tv.width-used)
+	}
+	return widths
+}
+
+// formatProcessRow pads the cells to the column widths
+func formatProcessRow(cells []string, widths []int) string {
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		value := truncateCell(processCell(cells, i), width)
+		if i == len(widths)-1 {
+			parts[i] = value
+		} else {
+			parts[i] = fmt.Sprintf("%-*s", width, value)
+		}
+	}
+	return strings.Join(parts, "  ")
+}
+
+// header renders the column titles, marking the sort column
+func (tv *TopView) header(widths []int) string {
+	titles := append([]string(nil), tv.titles...)
+	if col := tv.sortColumn(); col >= 0 {
+		marker := " ▲"
+		if tv.reverse {
+			marker = " ▼"
+		}
+		titles[col] += marker
+	}
+	return AppStyles.TableHeader.Render(formatProcessRow(titles, widths))
+}
+
+// refreshContent renders the processes into the viewport
+func (tv *TopView) refreshContent() {
+	switch {
+	case tv.err != nil:
+		tv.viewport.SetContent(StyleError(fmt.Sprintf("Error: %v", tv.err)))
+		return
+	case tv.titles == nil:
+		tv.viewport.SetContent(StyleMuted("Loading..."))
+		return
+	}
+
+	widths := tv.columnWidths()
+	lines := make([]string, len(tv.processes))
+	for i, row := range tv.processes {
+		lines[i] = formatProcessRow(row, widths)
+	}
+	tv.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// Render renders the title bar, the column titles and the visible processes
+func (tv *TopView) Render() string {
+	status := fmt.Sprintf("%d processes, sorted by %s", len(tv.processes), topSortNames[tv.sort])
+	if !tv.updated.IsZero() {
+		status += ", updated " + tv.updated.Format("15:04:05")
+	}
+	title := fmt.Sprintf("%s %s", StyleSubtitle(tv.title), StyleMuted("("+status+")"))
+
+	header := ""
+	if tv.err == nil && tv.titles != nil {
+		header = tv.header(tv.columnWidths())
+	}
+	return title + "\n" + header + "\n" + tv.viewport.View()
+}
+
+// topLoadedMsg carries the process list of a process view
+type topLoadedMsg struct {
+	key       string
+	titles    []string
+	processes [][]string
+	err       error
+}
+
+// showTop opens the process view for the selected container, or for every
+// running service of a compose group when the cursor is on its header
+func (m *Model) showTop() tea.Cmd {
+	var key, title string
+	var sources []topSource
+
+	if _, _, isGroupHeader := m.getSelectedItem(); isGroupHeader {
+		group := m.getSelectedGroup()
+		if group == nil {
+			return nil
+		}
+		for _, cont := range group.Containers {
+			if cont.State == "running" {
+				sources = append(sources, topSource{containerID: cont.ID, service: getServiceName(cont)})
+			}
+		}
+		if len(sources) == 0 {
+			m.status = fmt.Sprintf("No running containers in compose stack '%s'", group.Name)
+			return nil
+		}
+		key, title = "group:"+group.Name, fmt.Sprintf("Processes: %s (%d running)", group.Name, len(sources))
+	} else {
+		cont := m.containerTable.GetSelectedContainer()
+		if cont == nil {
+			return nil
+		}
+		if cont.State != "running" && cont.State != "paused" {
+			m.status = fmt.Sprintf("Container %s is not running", cont.ID[:12])
+			return nil
+		}
+		sources = []topSource{{containerID: cont.ID}}
+		key, title = cont.ID, "Processes: "+strings.TrimPrefix(cont.Names[0], "/")
+	}
+
+	m.topView = NewTopView(key, title, sources)
+	m.topView.SetSize(m.width, m.contentHeight())
+	m.previousView = m.currentView
+	m.currentView = TopViewMode
+	return m.fetchTop()
+}
+
+// fetchTop lists the processes of the process view's containers
+func (m *Model) fetchTop() tea.Cmd {
+	key, sources := m.topView.key, m.topView.sources
+	group := strings.HasPrefix(key, "group:")
+
+	return func() tea.Msg {
+		var titles []string
+		var processes [][]string
+
+		for _, source := range sources {
+			resp, err := m.dockerClient.ContainerTop(m.ctx, source.containerID, topArgs)
+			if err != nil {
+				// Fall back to the daemon's default columns if ps rejects the arguments
+				resp, err = m.dockerClient.ContainerTop(m.ctx, source.containerID, nil)
+			}
+			if err != nil {
+				if group {
+					continue // The container may have stopped since the view was opened
+				}
+				return topLoadedMsg{key: key, err: err}
+			}
+
+			if titles == nil {
+				titles = resp.Titles
+				if group {
+					titles = append([]string{"SERVICE"}, titles...)
+				}
+			}
+			for _, process := range resp.Processes {
+				if group {
+					process = append([]string{source.service}, process...)
+				}
+				processes = append(processes, process)
+			}
+		}
+
+		if titles == nil {
+			return topLoadedMsg{key: key, err: fmt.Errorf("no running containers")}
+		}
+		return topLoadedMsg{key: key, titles: titles, processes: processes}
+	}
+}
+
+// handleTopLoaded fills the process view if it is still showing the same containers
+func (m *Model) handleTopLoaded(msg topLoadedMsg) {
+	if m.topView == nil || m.topView.key != msg.key {
+		return
+	}
+	if msg.err != nil {
+		m.topView.SetError(msg.err)
+		return
+	}
+	m.topView.SetProcesses(msg.titles, msg.processes)
+}
+
+// closeTop leaves the process view
+func (m *Model) closeTop() {
+	m.topView = nil
+	if m.currentView == TopViewMode {
+		m.currentView = m.previousView
+	}
+}
+
+// handleTopKey handles key presses while the process view is active
+func (m *Model) handleTopKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "ctrl+c":
+		m.closeTop()
+		m.ticker.Stop()
+		return tea.Quit
+	case "esc", "q":
+		m.closeTop()
+		return nil
+	case "s":
+		m.topView.CycleSort()
+		return nil
+	case "r":
+		m.topView.ToggleReverse()
+		return nil
+	case "G", "end":
+		m.topView.viewport.GotoBottom()
+		return nil
+	case "home":
+		m.topView.viewport.GotoTop()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.topView.viewport, cmd = m.topView.viewport.Update(msg)
+	return cmd
+}
@@ -0,0 +1,345 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/distribution/reference"
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/pkg/jsonmessage"
+)
+
+// transferTickInterval is how often the progress of running transfers is redrawn
+const transferTickInterval = 250 * time.Millisecond
+
+// transfer is a long running copy between the daemon and a local file
+type transfer struct {
+	id      int
+	label   string
+	total   atomic.Int64 // Expected bytes, 0 when unknown
+	done    atomic.Int64
+	started time.Time
+}
+
+// progress describes how far the transfer got
+func (t *transfer) progress() string {
+	done, total := t.done.Load(), t.total.Load()
+	rate := float64(done) / max(time.Since(t.started).Seconds(), 0.001)
+	if total > 0 {
+		return fmt.Sprintf("%s: %.0f%% of %s (%s)", t.label, float64(done)/float64(total)*100, formatSize(total), formatRate(rate))
+	}
+	return fmt.Sprintf("%s: %s (%s)", t.label, formatSize(done), formatRate(rate))
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w     io.Writer
+	count *atomic.Int64
+}
+
+func (cw countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.count.Add(int64(n))
+	return n, err
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r     io.Reader
+	count *atomic.Int64
+}
+
+func (cr countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.count.Add(int64(n))
+	return n, err
+}
+
+// transferTickMsg redraws the progress of running transfers
+type transferTickMsg struct{}
+
+// transferDoneMsg reports a finished transfer
+type transferDoneMsg struct {
+	id      int
+	status  string
+	imageID string  // Set by imports so the new image can be selected
+	next    tea.Cmd // Run once the transfer succeeded, such as creating a container from a pulled image
+	err     error
+}
+
+func transferTick() tea.Cmd {
+	return tea.Tick(transferTickInterval, func(time.Time) tea.Msg {
+		return transferTickMsg{}
+	})
+}
+
+// startTransfer runs fn in the background and shows its progress in the footer
+func (m *Model) startTransfer(label string, total int64, fn func(ctx context.Context, t *transfer) transferDoneMsg) tea.Cmd {
+	m.transferID++
+	t := &transfer{id: m.transferID, label: label, started: time.Now()}
+	t.total.Store(total)
+	m.transfers = append(m.transfers, t)
+
+	run := func() tea.Msg {
+		msg := fn(m.ctx, t)
+		msg.id = t.id
+		return msg
+	}
+
+	// A single tick loop serves every running transfer
+	if len(m.transfers) == 1 {
+		return tea.Batch(run, transferTick())
+	}
+	return run
+}
+
+// handleTransferTick keeps redrawing while transfers are running
+func (m *Model) handleTransferTick() tea.Cmd {
+	if len(m.transfers) == 0 {
+		return nil
+	}
+	return transferTick()
+}
+
+// handleTransferDone forgets a finished transfer and reports its outcome
+func (m *Model) handleTransferDone(msg transferDoneMsg) tea.Cmd {
+	for i, t := range m.transfers {
+		if t.id == msg.id {
+			m.transfers = append(m.transfers[:i], m.transfers[i+1:]...)
+			break
+		}
+	}
+
+	if msg.err != nil {
+		m.err = msg.err
+		return nil
+	}
+	m.status = msg.status
+	m.err = nil
+
+	if msg.next != nil {
+		return msg.next
+	}
+	if msg.imageID != "" {
+		m.currentView = ImagesView
+		m.selectImageID = msg.imageID
+		m.selectImageStatus = msg.status
+		return m.refreshData()
+	}
+	return nil
+}
+
+// transfersStatus describes the running transfers for the footer
+func (m *Model) transfersStatus() string {
+	parts := make([]string, len(m.transfers))
+	for i, t := range m.transfers {
+		parts[i] = t.progress()
+	}
+	return strings.Join(parts, " │ ")
+}
+
+// promptExport asks where to write the filesystem of the selected container
+func (m *Model) promptExport() {
+	cont := m.containerTable.GetSelectedContainer()
+	if cont == nil {
+		return
+	}
+
+	container := *cont
+	name := strings.TrimPrefix(container.Names[0], "/")
+	path := fmt.Sprintf("%s-%s.tar", name, time.Now().Format("20060102-150405"))
+	m.showInput(fmt.Sprintf("Export filesystem of '%s' to:", name), "container.tar", path, func(path string) tea.Cmd {
+		if path == "" {
+			return nil
+		}
+		return m.exportContainer(container, path)
+	})
+}
+
+// exportContainer streams ContainerExport into a local tar file
+func (m *Model) exportContainer(cont container.Summary, path string) tea.Cmd {
+	name := strings.TrimPrefix(cont.Names[0], "/")
+
+	return m.startTransfer("Exporting "+name, 0, func(ctx context.Context, t *transfer) transferDoneMsg {
+		path, err := expandHome(path)
+		if err != nil {
+			return transferDoneMsg{err: err}
+		}
+
+		// Never overwrite an existing file
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if errors.Is(err, os.ErrExist) {
+			return transferDoneMsg{err: fmt.Errorf("%s already exists, export to another path", path)}
+		}
+		if err != nil {
+			return transferDoneMsg{err: err}
+		}
+
+		reader, err := m.dockerClient.ContainerExport(ctx, cont.ID)
+		if err != nil {
+			file.Close()
+			os.Remove(path)
+			return transferDoneMsg{err: err}
+		}
+		defer reader.Close()
+
+		_, err = io.Copy(countingWriter{w: file, count: &t.done}, reader)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(path) // Don't leave a truncated archive behind
+			return transferDoneMsg{err: fmt.Errorf("error exporting %s: %w", name, err)}
+		}
+
+		return transferDoneMsg{status: fmt.Sprintf("Exported %s to %s (%s)", name, path, formatSize(t.done.Load()))}
+	})
+}
+
+// showImportForm asks for a tarball to import as a new image
+func (m *Model) showImportForm() {
+	form := NewFormDialog("Import a tarball as an image").
+		AddInput("path", "Tarball", "./rootfs.tar", "").
+		AddInput("reference", "Repository[:tag]", "myrepo/imported:latest", "").
+		AddInput("message", "Message", "commit message", "").
+		AddTextArea("changes", "Dockerfile changes", `CMD ["sh"]`, "").
+		SetHint("reference", "Leave empty for an untagged image").
+		SetHint("changes", "One instruction per line, Alt+Enter for a new line (CMD, ENTRYPOINT, ENV, EXPOSE, LABEL, USER, VOLUME, WORKDIR)")
+
+	m.showForm(form, func(form *FormDialog) (tea.Cmd, error) {
+		path, err := expandHome(form.Value("path"))
+		if err != nil {
+			return nil, err
+		}
+		if path == "" {
+			return nil, errors.New("a tarball is required")
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			return nil, fmt.Errorf("%s is a directory", path)
+		}
+
+		ref := form.Value("reference")
+		if ref != "" {
+			named, err := reference.ParseNormalizedNamed(ref)
+			if err != nil {
+				return nil, fmt.Errorf("invalid reference: %w", err)
+			}
+			ref = reference.FamiliarString(reference.TagNameOnly(named))
+		}
+
+		options := image.ImportOptions{
+			Message: form.Value("message"),
+			Changes: dockerfileChanges(form),
+		}
+		return m.importImage(path, info.Size(), ref, options), nil
+	})
+}
+
+// importImage streams a local tarball to ImageImport
+func (m *Model) importImage(path string, size int64, ref string, options image.ImportOptions) tea.Cmd {
+	return m.startTransfer("Importing "+path, size, func(ctx context.Context, t *transfer) transferDoneMsg {
+		file, err := os.Open(path)
+		if err != nil {
+			return transferDoneMsg{err: err}
+		}
+		defer file.Close()
+
+		source := image.ImportSource{
+			Source:     countingReader{r: file, count: &t.done},
+			SourceName: "-",
+		}
+		resp, err := m.dockerClient.ImageImport(ctx, source, ref, options)
+		if err != nil {
+			return transferDoneMsg{err: err}
+		}
+		defer resp.Close()
+
+		// The daemon reports the new image ID as the status of the last message
+		var imageID string
+		dec := json.NewDecoder(resp)
+		for {
+			var msg jsonmessage.JSONMessage
+			if err := dec.Decode(&msg); err == io.EOF {
+				break
+			} else if err != nil {
+				return transferDoneMsg{err: err}
+			}
+			if msg.Error != nil {
+				return transferDoneMsg{err: msg.Error}
+			}
+			if msg.Status != "" {
+				imageID = strings.TrimSpace(msg.Status)
+			}
+		}
+
+		name := ref
+		if name == "" {
+			name = strings.TrimPrefix(imageID, "sha256:")
+		}
+		return transferDoneMsg{imageID: imageID, status: fmt.Sprintf("Imported %s as image %s", path, name)}
+	})
+}
+
+// pullImage pulls an image in the background, showing the download progress
+// in the footer, and runs next once the image is available
+func (m *Model) pullImage(ref string, next tea.Cmd) tea.Cmd {
+	return m.startTransfer("Pulling "+ref, 0, func(ctx context.Context, t *transfer) transferDoneMsg {
+		// Credentials from `docker login` are used when there are any
+		auth, _ := command.RetrieveAuthTokenFromImage(m.dockerCli.ConfigFile(), ref)
+		resp, err := m.dockerClient.ImagePull(ctx, ref, image.PullOptions{RegistryAuth: auth})
+		if err != nil {
+			return transferDoneMsg{err: fmt.Errorf("error pulling %s: %w", ref, err)}
+		}
+		defer resp.Close()
+
+		// Progress is reported per layer; the footer shows the sum
+		type layerProgress struct{ current, total int64 }
+		layers := make(map[string]layerProgress)
+		dec := json.NewDecoder(resp)
+		for {
+			var msg jsonmessage.JSONMessage
+			if err := dec.Decode(&msg); err == io.EOF {
+				break
+			} else if err != nil {
+				return transferDoneMsg{err: fmt.Errorf("error pulling %s: %w", ref, err)}
+			}
+			if msg.Error != nil {
+				return transferDoneMsg{err: fmt.Errorf("error pulling %s: %w", ref, msg.Error)}
+			}
+
+			switch {
+			case msg.Status == "Downloading" && msg.Progress != nil:
+				layers[msg.ID] = layerProgress{current: msg.Progress.Current, total: msg.Progress.Total}
+			case msg.Status == "Download complete":
+				layer := layers[msg.ID]
+				layer.current = layer.total
+				layers[msg.ID] = layer
+			default:
+				continue
+			}
+			var done, total int64
+			for _, layer := range layers {
+				done += layer.current
+				total += layer.total
+			}
+			t.done.Store(done)
+			t.total.Store(total)
+		}
+
+		return transferDoneMsg{status: fmt.Sprintf("Pulled image %s", ref), next: next}
+	})
+}
@@ -17,6 +17,7 @@ import (
 func (m *Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.refreshData(),
+		m.waitForStats(),
 		tea.Tick(time.Second, func(t time.Time) tea.Msg {
 			return tickMsg(t)
 		}),
@@ -34,9 +35,39 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.ready = true
 		m.updateTableSizes()
 		m.helpView.SetSize(msg.Width, msg.Height)
+		if m.logView != nil {
+			m.logView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.outputView != nil {
+			m.outputView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.inspectView != nil {
+			m.inspectView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.statsView != nil {
+			m.statsView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.topView != nil {
+			m.topView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.diffView != nil {
+			m.diffView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.fileBrowser != nil {
+			m.fileBrowser.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.envView != nil {
+			m.envView.SetSize(msg.Width, m.contentHeight())
+		}
+		if m.mountsView != nil {
+			m.mountsView.SetSize(msg.Width, m.contentHeight())
+		}
 
 	case tickMsg:
 		cmds = append(cmds, m.refreshData())
+		if m.currentView == TopViewMode && m.topView != nil {
+			cmds = append(cmds, m.fetchTop())
+		}
 		cmds = append(cmds, tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
 			return tickMsg(t)
 		}))
@@ -59,8 +90,107 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		if m.inputDialog != nil && m.inputDialog.IsVisible() {
+			switch msg.String() {
+			case "enter":
+				value := m.inputDialog.Value()
+				m.inputDialog.Hide()
+				if m.pendingInput != nil {
+					cmds = append(cmds, m.pendingInput(value))
+					m.pendingInput = nil
+				}
+			case "esc":
+				m.inputDialog.Hide()
+				m.pendingInput = nil
+			default:
+				cmds = append(cmds, m.inputDialog.Update(msg))
+			}
+			return m, tea.Batch(cmds...)
+		}
+
+		if m.pickerDialog != nil && m.pickerDialog.IsVisible() {
+			switch msg.String() {
+			case "up", "k":
+				m.pickerDialog.MoveCursor(-1)
+			case "down", "j", "tab":
+				m.pickerDialog.MoveCursor(1)
+			case "enter":
+				selected := m.pickerDialog.Selected()
+				m.pickerDialog.Hide()
+				if m.pendingPick != nil {
+					pick := m.pendingPick
+					m.pendingPick = nil
+					cmds = append(cmds, pick(selected))
+				}
+			case "esc":
+				m.pickerDialog.Hide()
+				m.pendingPick = nil
+			}
+			return m, tea.Batch(cmds...)
+		}
+
+		if m.formDialog != nil && m.formDialog.IsVisible() {
+			switch msg.String() {
+			case "enter":
+				// Multi-step forms validate and move on before submitting
+				if m.formDialog.NextStep() {
+					return m, nil
+				}
+				// The form stays open with the error shown until it validates
+				if m.pendingForm != nil {
+					cmd, err := m.pendingForm(m.formDialog)
+					if err != nil {
+						m.formDialog.SetError(err)
+						return m, nil
+					}
+					cmds = append(cmds, cmd)
+					m.pendingForm = nil
+				}
+				m.formDialog.Hide()
+			case "esc":
+				if m.formDialog.PrevStep() {
+					return m, nil
+				}
+				m.formDialog.Hide()
+				m.pendingForm = nil
+			default:
+				cmds = append(cmds, m.formDialog.Update(msg))
+			}
+			return m, tea.Batch(cmds...)
+		}
+
+		// The log view owns the keyboard while it is open
+		if m.currentView == LogsView && m.logView != nil {
+			return m, m.handleLogsKey(msg)
+		}
+		if m.currentView == OutputViewMode && m.outputView != nil {
+			return m, m.handleOutputKey(msg)
+		}
+		if m.currentView == InspectViewMode && m.inspectView != nil {
+			return m, m.handleInspectKey(msg)
+		}
+		if m.currentView == StatsViewMode && m.statsView != nil {
+			return m, m.handleStatsHistoryKey(msg)
+		}
+		if m.currentView == TopViewMode && m.topView != nil {
+			return m, m.handleTopKey(msg)
+		}
+		if m.currentView == DiffViewMode && m.diffView != nil {
+			return m, m.handleDiffKey(msg)
+		}
+		if m.currentView == FilesViewMode && m.fileBrowser != nil {
+			return m, m.handleFilesKey(msg)
+		}
+		if m.currentView == EnvViewMode && m.envView != nil {
+			return m, m.handleEnvKey(msg)
+		}
+		if m.currentView == MountsViewMode && m.mountsView != nil {
+			return m, m.handleMountsKey(msg)
+		}
+
 		switch {
 		case key.Matches(msg, m.keys.Quit):
+			m.closeLogs()
 			m.ticker.Stop()
 			return m, tea.Quit
 
@@ -79,9 +209,29 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Volumes):
 			m.currentView = VolumesView
 
+		case key.Matches(msg, m.keys.Resources):
+			m.currentView = ResourcesView
+			m.resourceTable.Update()
+
+		case m.currentView == ResourcesView && key.Matches(msg, m.keys.ResourceSort):
+			m.resourceTable.CycleMetric()
+
+		case m.currentView == ResourcesView && key.Matches(msg, m.keys.SpanLonger):
+			m.resourceTable.ChangeSpan(1)
+
+		case m.currentView == ResourcesView && key.Matches(msg, m.keys.SpanShorter):
+			m.resourceTable.ChangeSpan(-1)
+
 		case key.Matches(msg, m.keys.Refresh):
 			cmds = append(cmds, m.refreshData())
 
+		case key.Matches(msg, m.keys.Enter):
+			if m.currentView == ResourcesView {
+				m.showResourceContainer()
+			} else {
+				cmds = append(cmds, m.showInspect())
+			}
+
 		case key.Matches(msg, m.keys.Delete):
 			// Show confirmation dialog
 			m.showDeleteConfirmation()
@@ -91,6 +241,102 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.showStopConfirmation()
 			}
 
+		case key.Matches(msg, m.keys.Start):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.startSelectedContainer())
+			}
+
+		case key.Matches(msg, m.keys.Restart):
+			if m.currentView == ContainersView {
+				m.showRestartConfirmation()
+			}
+
+		case key.Matches(msg, m.keys.Pause):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.togglePause())
+			}
+
+		case key.Matches(msg, m.keys.Kill):
+			if m.currentView == ContainersView {
+				m.showKillPicker()
+			}
+
+		case key.Matches(msg, m.keys.Top):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showTop())
+			}
+
+		case key.Matches(msg, m.keys.Diff):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showDiff())
+			}
+
+		case key.Matches(msg, m.keys.Files):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showFiles())
+			}
+
+		case key.Matches(msg, m.keys.Commit):
+			if m.currentView == ContainersView {
+				m.showCommitForm()
+			}
+
+		case key.Matches(msg, m.keys.Export):
+			if m.currentView == ContainersView {
+				m.promptExport()
+			}
+
+		case key.Matches(msg, m.keys.Import):
+			if m.currentView == ImagesView {
+				m.showImportForm()
+			}
+
+		case key.Matches(msg, m.keys.Create):
+			if m.currentView == ContainersView || m.currentView == ImagesView {
+				m.showCreateWizard()
+			}
+
+		case key.Matches(msg, m.keys.Recreate):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showRecreate())
+			}
+
+		case key.Matches(msg, m.keys.Limits):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showUpdateLimits())
+			}
+
+		case key.Matches(msg, m.keys.Rename):
+			if m.currentView == ContainersView {
+				m.showRenamePrompt()
+			}
+
+		case key.Matches(msg, m.keys.Env):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.showEnv())
+			}
+
+		case key.Matches(msg, m.keys.Mounts):
+			if m.currentView == ContainersView {
+				m.showMounts()
+			}
+
+		case key.Matches(msg, m.keys.Connect):
+			switch m.currentView {
+			case ContainersView:
+				m.showConnectPicker()
+			case NetworksView:
+				m.showNetworkConnectPicker()
+			}
+
+		case key.Matches(msg, m.keys.Disconnect):
+			switch m.currentView {
+			case ContainersView:
+				m.showDisconnectPicker()
+			case NetworksView:
+				m.showNetworkDisconnectPicker()
+			}
+
 		case key.Matches(msg, m.keys.Help):
 			m.showHelp = !m.showHelp
 
@@ -99,6 +345,16 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				cmds = append(cmds, m.showLogs())
 			}
 
+		case key.Matches(msg, m.keys.Shell):
+			if m.currentView == ContainersView {
+				cmds = append(cmds, m.openShell())
+			}
+
+		case key.Matches(msg, m.keys.RunCommand):
+			if m.currentView == ContainersView {
+				m.promptCommand()
+			}
+
 		case key.Matches(msg, m.keys.ThemeDefault):
 			m.SetDefaultTheme()
 
@@ -108,6 +364,18 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.ThemeLight):
 			m.SetLightTheme()
 
+		case key.Matches(msg, m.keys.StatsToggle):
+			if m.currentView == ContainersView {
+				m.toggleStats()
+				// The table binds "u" to half a page up; don't scroll as well
+				return m, tea.Batch(cmds...)
+			}
+
+		case key.Matches(msg, m.keys.StatsHistory):
+			if m.currentView == ContainersView {
+				m.showStatsHistory()
+			}
+
 		case key.Matches(msg, m.keys.GroupToggle):
 			if m.currentView == ContainersView {
 				m.groupByCompose = !m.groupByCompose
@@ -146,6 +414,63 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case dataRefreshedMsg:
 		m.handleDataRefresh(msg)
 
+	case logLinesMsg:
+		cmds = append(cmds, m.handleLogLines(msg))
+
+	case logStreamClosedMsg:
+		m.handleLogStreamClosed(msg)
+
+	case shellMsg:
+		cmds = append(cmds, m.handleShellExit(msg))
+
+	case commandResultMsg:
+		m.handleCommandResult(msg)
+
+	case commandTickMsg:
+		cmds = append(cmds, m.handleCommandTick(msg))
+
+	case inspectLoadedMsg:
+		m.handleInspectLoaded(msg)
+
+	case topLoadedMsg:
+		m.handleTopLoaded(msg)
+
+	case diffLoadedMsg:
+		m.handleDiffLoaded(msg)
+
+	case envLoadedMsg:
+		m.handleEnvLoaded(msg)
+
+	case filesListedMsg:
+		m.handleFilesListed(msg)
+
+	case filePreviewMsg:
+		m.handleFilePreview(msg)
+
+	case filesUploadedMsg:
+		cmds = append(cmds, m.handleFilesUploaded(msg))
+
+	case containerCommittedMsg:
+		cmds = append(cmds, m.handleContainerCommitted(msg))
+
+	case limitsInspectedMsg:
+		m.handleLimitsInspected(msg)
+
+	case recreateInspectedMsg:
+		m.handleRecreateInspected(msg)
+
+	case containerCreatedMsg:
+		cmds = append(cmds, m.handleContainerCreated(msg))
+
+	case transferTickMsg:
+		cmds = append(cmds, m.handleTransferTick())
+
+	case transferDoneMsg:
+		cmds = append(cmds, m.handleTransferDone(msg))
+
+	case statsMsg:
+		cmds = append(cmds, m.handleStats(msg))
+
 	case errorMsg:
 		m.err = msg.error
 		m.status = ""
@@ -174,6 +499,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			table := m.volumeTable.GetTable()
 			table, cmd = table.Update(msg)
 			m.volumeTable.SetTable(table)
+		case ResourcesView:
+			table := m.resourceTable.GetTable()
+			table, cmd = table.Update(msg)
+			m.resourceTable.SetTable(table)
 		}
 	}
 
@@ -209,8 +538,45 @@ func (m *Model) View() string {
 		content.WriteString(m.networkTable.View())
 	case VolumesView:
 		content.WriteString(m.volumeTable.View())
+	case ResourcesView:
+		content.WriteString(m.resourceTable.View())
 	case LogsView:
-		content.WriteString("Container logs view (coming soon)")
+		if m.logView != nil {
+			content.WriteString(m.logView.Render())
+		}
+	case OutputViewMode:
+		if m.outputView != nil {
+			content.WriteString(m.outputView.Render())
+		}
+	case InspectViewMode:
+		if m.inspectView != nil {
+			content.WriteString(m.inspectView.Render())
+		}
+	case TopViewMode:
+		if m.topView != nil {
+			content.WriteString(m.topView.Render())
+		}
+	case DiffViewMode:
+		if m.diffView != nil {
+			content.WriteString(m.diffView.Render())
+		}
+	case FilesViewMode:
+		if m.fileBrowser != nil {
+			content.WriteString(m.fileBrowser.Render())
+		}
+	case EnvViewMode:
+		if m.envView != nil {
+			content.WriteString(m.envView.Render())
+		}
+	case MountsViewMode:
+		if m.mountsView != nil {
+			content.WriteString(m.mountsView.Render())
+		}
+	case StatsViewMode:
+		if m.statsView != nil {
+			series := m.statsSeries(m.statsView.containerIDs)
+			content.WriteString(m.statsView.Render(series, m.stats))
+		}
 	}
 
 	content.WriteString("\n\n")
@@ -226,6 +592,18 @@ func (m *Model) View() string {
 		return dialog
 	}
 
+	if m.inputDialog != nil && m.inputDialog.IsVisible() {
+		return m.inputDialog.Render()
+	}
+
+	if m.pickerDialog != nil && m.pickerDialog.IsVisible() {
+		return m.pickerDialog.Render()
+	}
+
+	if m.formDialog != nil && m.formDialog.IsVisible() {
+		return m.formDialog.Render()
+	}
+
 	return view
 }
 
@@ -238,6 +616,9 @@ func (m *Model) nextView() {
 	case NetworksView:
 		m.currentView = VolumesView
 	case VolumesView:
+		m.currentView = ResourcesView
+		m.resourceTable.Update()
+	case ResourcesView:
 		m.currentView = ContainersView
 	}
 }
@@ -245,7 +626,7 @@ func (m *Model) nextView() {
 func (m *Model) renderHeader() string {
 	var tabs []string
 
-	for view := ContainersView; view <= VolumesView; view++ {
+	for view := ContainersView; view <= ResourcesView; view++ {
 		name := viewNames[view]
 		tabs = append(tabs, StyleTab(name, view == m.currentView))
 	}
@@ -259,26 +640,167 @@ func (m *Model) renderFooter() string {
 	var help []string
 
 	switch m.currentView {
+	case FilesViewMode:
+		if m.fileBrowser != nil && m.fileBrowser.previewPath != "" {
+			help = []string{
+				"↑/↓: scroll",
+				"s: download",
+				"esc/q: back to listing",
+			}
+		} else {
+			help = []string{
+				"↑/↓: move",
+				"enter: open",
+				"backspace: parent",
+				"/: root",
+				"s: download",
+				"u: upload here",
+				"r: reload",
+				"esc/q: back",
+			}
+		}
+	case MountsViewMode:
+		help = []string{
+			"↑/↓: move",
+			"enter: go to volume",
+			"esc/q: back",
+		}
+	case EnvViewMode:
+		help = []string{
+			"↑/↓: move",
+			"enter/space: reveal/mask",
+			"m: mask secrets",
+			"s: sort definition/key",
+			"r: reverse",
+			"R: reload",
+			"esc/q: back",
+		}
+	case DiffViewMode:
+		help = []string{
+			"↑/↓: move",
+			"enter/←/→: collapse/expand",
+			"/: path prefix",
+			"r: reload",
+			"esc/q: back",
+		}
+	case TopViewMode:
+		help = []string{
+			"↑/↓: scroll",
+			"s: sort pid/cpu/command",
+			"r: reverse",
+			"esc/q: back",
+			"ctrl+c: quit",
+		}
+	case ResourcesView:
+		help = []string{
+			"1-5: switch views",
+			"↑/↓: navigate",
+			"enter: go to container",
+			"s: rank by",
+			"+/-: span",
+			"q: quit",
+		}
+	case StatsViewMode:
+		help = []string{
+			"esc/q: back",
+			"ctrl+c: quit",
+		}
+	case InspectViewMode:
+		help = []string{
+			"↑/↓: move",
+			"enter/←/→: collapse/expand",
+			"c/e: collapse/expand all",
+			"y: json/yaml",
+			"/: search",
+			"n/N: next/prev match",
+			"esc/q: back",
+		}
+	case OutputViewMode:
+		help = []string{
+			"↑/↓: scroll",
+			"pgup/pgdn: page",
+			"home/G: top/bottom",
+			"esc/q: back",
+			"ctrl+c: quit",
+		}
+	case LogsView:
+		help = []string{
+			"↑/↓: scroll",
+			"pgup/pgdn: page",
+			"home/G: top/bottom",
+			"/: search",
+			"n/N: next/prev match",
+			"f: grep mode",
+			"p: raw/pretty",
+			"s/u/t: since/until/tail",
+			"T: timestamps",
+			"w: export",
+			"esc/q: back",
+			"ctrl+c: quit",
+		}
 	case ContainersView:
 		help = []string{
-			"1-4: switch views",
+			"1-5: switch views",
 			"↑/↓: navigate",
 			"r: refresh",
 			"g: group toggle",
+			"u: usage columns",
+			"H: stats history",
+			"a: start",
 			"ctrl+s: stop",
+			"R: restart",
+			"p: pause/unpause",
+			"K: kill",
+			"T: processes",
+			"F: changes",
+			"b: files",
+			"C: commit",
+			"E: export",
+			"n: run new",
+			"e: edit/recreate",
+			"U: limits",
+			"m: rename",
+			"v: env",
+			"M: mounts",
+			"w/W: connect/disconnect network",
 			"d: delete",
+			"enter: inspect",
 			"L: logs",
+			"x: shell",
+			"!: run command",
 			"q: quit",
 		}
 		if m.groupByCompose {
 			help = append(help, "[grouped by compose]")
 			help = append(help, "s: stop group", "S: start group", "D: delete group")
 		}
+	case NetworksView:
+		help = []string{
+			"1-5: switch views",
+			"↑/↓: navigate",
+			"r: refresh",
+			"enter: inspect",
+			"d: delete",
+			"w/W: connect/disconnect container",
+			"q: quit",
+		}
+	case ImagesView:
+		help = []string{
+			"1-5: switch views",
+			"↑/↓: navigate",
+			"r: refresh",
+			"enter: inspect",
+			"d: delete",
+			"I: import",
+			"n: run container",
+			"q: quit",
+		}
 	default:
 		help = []string{
-			"1-4: switch views",
+			"1-5: switch views",
 			"↑/↓: navigate",
 			"r: refresh",
+			"enter: inspect",
 			"d: delete",
 			"q: quit",
 		}
@@ -295,6 +817,14 @@ func (m *Model) renderFooter() string {
 		status = StyleError(fmt.Sprintf("Error: %v", m.err))
 	}
 
+	if len(m.transfers) > 0 {
+		progress := m.styles.StatusInfo.Render(m.transfersStatus())
+		if status != "" {
+			progress += " │ " + status
+		}
+		status = progress
+	}
+
 	return fmt.Sprintf("%s\n%s", helpText, status)
 }
 
@@ -303,19 +833,26 @@ func (m *Model) updateTableSizes() {
 		return
 	}
 
-	tableHeight := m.height - 10 // Reserve space for header and footer
-	if tableHeight < 5 {
-		tableHeight = 5
-	}
+	tableHeight := m.contentHeight()
 
 	m.containerTable.SetHeight(tableHeight)
 	m.imageTable.SetHeight(tableHeight)
 	m.networkTable.SetHeight(tableHeight)
 	m.volumeTable.SetHeight(tableHeight)
+	m.resourceTable.SetHeight(tableHeight - 1) // Leave room for the ranking line
 
 	m.updateColumnWidths()
 }
 
+// contentHeight returns the height available between the header and the footer
+func (m *Model) contentHeight() int {
+	height := m.height - 10 // Reserve space for header and footer
+	if height < 5 {
+		height = 5
+	}
+	return height
+}
+
 func (m *Model) updateColumnWidths() {
 	// Reserve some space for borders and padding
 	availableWidth := m.width - 4
@@ -331,6 +868,9 @@ func (m *Model) updateColumnWidths() {
 
 	volumeColumns := m.calculateVolumeColumnWidths(availableWidth)
 	m.volumeTable.SetColumns(volumeColumns)
+
+	resourceColumns := m.calculateResourceColumnWidths(availableWidth)
+	m.resourceTable.SetColumns(resourceColumns)
 }
 
 // TODO: move these to a separate file for better organization
@@ -339,6 +879,12 @@ func (m *Model) calculateContainerColumnWidths(availableWidth int) []table.Colum
 	preferredWidths := []int{12, 30, 25, 16, 20, 25, 20}
 	titles := []string{"ID", "Names", "Image", "Command", "Created", "Status", "Ports"}
 
+	if m.showStats {
+		minWidths = append(minWidths, 8, 15, 15, 15)
+		preferredWidths = append(preferredWidths, 8, 20, 18, 18)
+		titles = append(titles, "CPU %", "Mem Usage / Limit", "Net I/O", "Block I/O")
+	}
+
 	return m.distributeColumnWidths(titles, minWidths, preferredWidths, availableWidth)
 }
 
@@ -366,6 +912,14 @@ func (m *Model) calculateVolumeColumnWidths(availableWidth int) []table.Column {
 	return m.distributeColumnWidths(titles, minWidths, preferredWidths, availableWidth)
 }
 
+func (m *Model) calculateResourceColumnWidths(availableWidth int) []table.Column {
+	minWidths := []int{15, 8, 8, 10, 8, 18, 18} // Name, Level, CPU %, Memory, Mem %, Net RX / TX, Block R / W
+	preferredWidths := []int{30, 8, 10, 12, 8, 24, 24}
+	titles := []string{"Name", "Level", "CPU %", "Memory", "Mem %", "Net RX / TX", "Block R / W"}
+
+	return m.distributeColumnWidths(titles, minWidths, preferredWidths, availableWidth)
+}
+
 func (m *Model) distributeColumnWidths(titles []string, minWidths, preferredWidths []int, availableWidth int) []table.Column {
 	if len(titles) != len(minWidths) || len(titles) != len(preferredWidths) {
 		columns := make([]table.Column, len(titles))
@@ -462,13 +1016,6 @@ func (m *Model) refreshData() tea.Cmd {
 	}
 }
 
-func (m *Model) showLogs() tea.Cmd {
-	return func() tea.Msg {
-		// TODO
-		return statusMsg("Logs view not implemented yet")
-	}
-}
-
 func (m *Model) showDeleteConfirmation() {
 	var message string
 	var hasSelection bool
@@ -637,9 +1184,45 @@ func (m *Model) handleDataRefresh(msg dataRefreshedMsg) {
 	m.imageTable.Update()
 	m.networkTable.Update()
 	m.volumeTable.Update()
+	m.syncStatsStreams()
+	m.resourceTable.Update()
 
 	m.status = fmt.Sprintf("Last updated: %s", time.Now().Format("15:04:05"))
 	m.err = nil
+
+	if m.selectImageID != "" {
+		m.imageTable.SelectImage(m.selectImageID)
+		m.status = m.selectImageStatus
+		m.selectImageID, m.selectImageStatus = "", ""
+	}
+	if m.selectContainerID != "" {
+		m.containerTable.SelectContainer(m.selectContainerID)
+		m.status = m.selectContainerStatus
+		m.selectContainerID, m.selectContainerStatus = "", ""
+	}
+}
+
+// showInput opens the input dialog and calls onSubmit with the entered value
+func (m *Model) showInput(message, placeholder, value string, onSubmit func(string) tea.Cmd) {
+	m.inputDialog = NewInputDialog(message, placeholder)
+	m.inputDialog.SetValue(value)
+	m.inputDialog.SetSize(m.width, m.height)
+	m.pendingInput = onSubmit
+}
+
+// showPicker opens the picker dialog and calls onSelect with the chosen option
+func (m *Model) showPicker(message string, options []string, onSelect func(string) tea.Cmd) {
+	m.pickerDialog = NewPickerDialog(message, options)
+	m.pickerDialog.SetSize(m.width, m.height)
+	m.pendingPick = onSelect
+}
+
+// showForm opens the form dialog; onSubmit returning an error keeps the form
+// open with the error shown
+func (m *Model) showForm(form *FormDialog, onSubmit func(*FormDialog) (tea.Cmd, error)) {
+	m.formDialog = form
+	m.formDialog.SetSize(m.width, m.height)
+	m.pendingForm = onSubmit
 }
 
 func (m *Model) showStopConfirmation() {
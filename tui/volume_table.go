@@ -106,6 +106,17 @@ func (vt *VolumeTable) GetSelectedVolume() *volume.Volume {
 	return nil
 }
 
+// SelectVolume moves the cursor to the volume with the given name, reporting whether it is listed
+func (vt *VolumeTable) SelectVolume(name string) bool {
+	for i, vol := range vt.model.volumes {
+		if vol.Name == name {
+			vt.table.SetCursor(i)
+			return true
+		}
+	}
+	return false
+}
+
 // View returns the rendered table view
 func (vt *VolumeTable) View() string {
 	return vt.table.View()